@@ -0,0 +1,123 @@
+package rotato
+
+import (
+	"math/rand"
+	"time"
+)
+
+// holdDuration is how long the last frame of the built-in bar animations is
+// displayed.
+const holdDuration = 500 * time.Millisecond
+
+// Animation represents a named symbol set with its recommended interval.
+type Animation struct {
+	Name     string        // Animation name
+	Frames   []string      // Animation frames
+	Interval time.Duration // Recommended frequency, zero keeps the current one
+
+	// Durations holds optional per frame durations, indexed like Frames.
+	// Missing or zero durations use the spinner frequency.
+	Durations []time.Duration
+}
+
+// Width returns the display width of the widest frame.
+func (a Animation) Width() int {
+	w := 0
+	for _, f := range a.Frames {
+		w = max(w, textWidth(f))
+	}
+
+	return w
+}
+
+// WithAnimation returns an option function that sets the spinner animation
+// and, unless set with WithSpinnerFrequency, its frequency and frame
+// durations to the animation ones. Use it with UpdateSymbols to change the
+// animation mid-run.
+func WithAnimation(a Animation) Option {
+	return func(sp *Spinner) {
+		sp.frames = framesOf(a.Frames)
+		sp.animDurations = append([]time.Duration(nil), a.Durations...)
+		if a.Interval > 0 && !sp.frequencyPinned {
+			sp.frequency = a.Interval
+		}
+	}
+}
+
+// withInterval returns an option function that sets the spinner symbols
+// with a recommended interval.
+func withInterval(d time.Duration, symbols ...string) Option {
+	return WithAnimation(Animation{Frames: symbols, Interval: d})
+}
+
+// withHold returns an option function that sets the spinner symbols with a
+// recommended interval, holding the last frame for holdDuration.
+func withHold(d time.Duration, symbols ...string) Option {
+	durations := make([]time.Duration, len(symbols))
+	durations[len(durations)-1] = holdDuration
+
+	return WithAnimation(Animation{Frames: symbols, Interval: d, Durations: durations})
+}
+
+// animationOf returns the animation set by the given option.
+func animationOf(name string, opt Option) Animation {
+	sp := &Spinner{}
+	opt(sp)
+
+	return Animation{
+		Name:      name,
+		Frames:    sp.Symbols(),
+		Interval:  sp.frequency,
+		Durations: sp.animDurations,
+	}
+}
+
+// Playback represents the order in which the animation frames are played.
+type Playback int
+
+const (
+	// PlaybackForward plays the frames from first to last.
+	PlaybackForward Playback = iota
+	// PlaybackReverse plays the frames from last to first.
+	PlaybackReverse
+	// PlaybackPingPong plays the frames forward and then backward, without
+	// repeating the first and last frames.
+	PlaybackPingPong
+	// PlaybackRandom plays a random frame on every tick.
+	PlaybackRandom
+)
+
+// WithPlayback returns an option function that sets the order in which the
+// spinner frames are played.
+func WithPlayback(p Playback) Option {
+	return func(sp *Spinner) {
+		sp.playback = p
+	}
+}
+
+// frameIndex returns the index of the frame to display on iteration i for
+// n frames.
+func (sp *Spinner) frameIndex(i, n int) int {
+	switch sp.playback {
+	case PlaybackReverse:
+		return n - 1 - i%n
+	case PlaybackPingPong:
+		if n < 2 {
+			return 0
+		}
+		period := 2*n - 2
+		k := i % period
+		if k < n {
+			return k
+		}
+		return period - k
+	case PlaybackRandom:
+		if n < 2 {
+			return 0
+		}
+		// skip the current frame so the animation never stalls.
+		return (sp.frameIdx + 1 + rand.Intn(n-1)) % n //nolint:gosec // not security sensitive
+	}
+
+	return i % n
+}
@@ -0,0 +1,31 @@
+package rotato
+
+import "time"
+
+// Clock provides the time to a spinner. The animation waits on After
+// between frames, and elapsed-time features measure from Now.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// WithClock returns an option function that sets the clock used for the
+// animation and elapsed time, useful for deterministic tests.
+func WithClock(c Clock) Option {
+	return func(sp *Spinner) {
+		if c != nil {
+			sp.clock = c
+		}
+	}
+}
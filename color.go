@@ -0,0 +1,359 @@
+package rotato
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ColorProfile represents the color capability of a terminal.
+type ColorProfile int
+
+const (
+	// ProfileNoColor disables colors, text attributes like bold are kept.
+	ProfileNoColor ColorProfile = iota
+	// ProfileANSI supports the 16 basic ANSI colors.
+	ProfileANSI
+	// ProfileANSI256 supports the 256 colors palette.
+	ProfileANSI256
+	// ProfileTrueColor supports 24-bit colors.
+	ProfileTrueColor
+)
+
+// sgrRe matches SGR (Select Graphic Rendition) escape sequences.
+var sgrRe = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// ansiPalette holds the RGB values of the 16 basic ANSI colors, using the
+// xterm defaults.
+var ansiPalette = [16][3]uint8{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// WithColorProfile returns an option function that sets the spinner color
+// profile, overriding the one detected from the environment.
+func WithColorProfile(p ColorProfile) Option {
+	return func(sp *Spinner) {
+		sp.colorProfile = p
+	}
+}
+
+// DetectColorProfile returns the color profile supported by the terminal,
+// based on the `NO_COLOR`, `FORCE_COLOR`, `TERM` and `COLORTERM` environment
+// variables.
+func DetectColorProfile() ColorProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return ProfileNoColor
+	}
+
+	p := termProfile()
+	switch strings.ToLower(os.Getenv("FORCE_COLOR")) {
+	case "":
+		return p
+	case "0", "false":
+		return ProfileNoColor
+	case "2":
+		return max(p, ProfileANSI256)
+	case "3":
+		return ProfileTrueColor
+	default:
+		return max(p, ProfileANSI)
+	}
+}
+
+// termProfile returns the color profile advertised by `TERM` and `COLORTERM`.
+func termProfile() ColorProfile {
+	term := strings.ToLower(os.Getenv("TERM"))
+	if term == "dumb" {
+		return ProfileNoColor
+	}
+
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+
+	switch {
+	case strings.Contains(term, "truecolor"), strings.Contains(term, "direct"):
+		return ProfileTrueColor
+	case strings.Contains(term, "256color"):
+		return ProfileANSI256
+	default:
+		return ProfileANSI
+	}
+}
+
+// degrade rewrites the SGR sequences in s so they fit the given color
+// profile.
+func degrade(s string, p ColorProfile) string {
+	if p >= ProfileTrueColor || !strings.Contains(s, "\x1b[") {
+		return s
+	}
+
+	return sgrRe.ReplaceAllStringFunc(s, func(seq string) string {
+		params := sgrRe.FindStringSubmatch(seq)[1]
+		if params == "" {
+			return seq
+		}
+		out := degradeParams(strings.Split(params, ";"), p)
+		if len(out) == 0 {
+			return ""
+		}
+
+		return "\x1b[" + strings.Join(out, ";") + "m"
+	})
+}
+
+// degradeParams degrades a list of SGR parameters to the given color
+// profile.
+func degradeParams(params []string, p ColorProfile) []string {
+	out := make([]string, 0, len(params))
+	for i := 0; i < len(params); i++ {
+		n, err := strconv.Atoi(params[i])
+		if err != nil {
+			out = append(out, params[i])
+			continue
+		}
+
+		switch {
+		case n == 38 || n == 48:
+			bg := n == 48
+			rgb, idx, consumed, ok := parseExtendedColor(params[i+1:])
+			i += consumed
+			if !ok || p == ProfileNoColor {
+				continue
+			}
+			out = append(out, extendedColorParams(bg, rgb, idx, p)...)
+		case isBasicColorParam(n):
+			if p == ProfileNoColor {
+				continue
+			}
+			out = append(out, params[i])
+		default:
+			out = append(out, params[i])
+		}
+	}
+
+	return out
+}
+
+// parseExtendedColor parses the arguments of a `38`/`48` SGR parameter. It
+// returns either a 256 palette index (idx >= 0) or an RGB value (idx == -1),
+// and the number of parameters consumed.
+func parseExtendedColor(args []string) (rgb [3]uint8, idx, consumed int, ok bool) {
+	if len(args) == 0 {
+		return rgb, -1, 0, false
+	}
+
+	switch args[0] {
+	case "5":
+		if len(args) < 2 {
+			return rgb, -1, len(args), false
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 || n > 255 {
+			return rgb, -1, 2, false
+		}
+		return rgb, n, 2, true
+	case "2":
+		if len(args) < 4 {
+			return rgb, -1, len(args), false
+		}
+		for j := 0; j < 3; j++ {
+			n, err := strconv.Atoi(args[j+1])
+			if err != nil || n < 0 || n > 255 {
+				return rgb, -1, 4, false
+			}
+			rgb[j] = uint8(n)
+		}
+		return rgb, -1, 4, true
+	}
+
+	return rgb, -1, 1, false
+}
+
+// extendedColorParams returns the SGR parameters for an extended color,
+// degraded to the given profile.
+func extendedColorParams(bg bool, rgb [3]uint8, idx int, p ColorProfile) []string {
+	base := "38"
+	if bg {
+		base = "48"
+	}
+
+	switch p {
+	case ProfileANSI256:
+		if idx < 0 {
+			idx = rgbTo256(rgb)
+		}
+		return []string{base, "5", strconv.Itoa(idx)}
+	case ProfileANSI:
+		if idx < 0 {
+			idx = rgbTo16(rgb)
+		} else if idx >= 16 {
+			idx = rgbTo16(ansi256ToRGB(idx))
+		}
+		return []string{strconv.Itoa(ansi16Param(idx, bg))}
+	}
+
+	return nil
+}
+
+// isBasicColorParam reports whether n is a 16 colors foreground or
+// background SGR parameter, including the default color ones.
+func isBasicColorParam(n int) bool {
+	return (n >= 30 && n <= 37) || n == 39 ||
+		(n >= 40 && n <= 47) || n == 49 ||
+		(n >= 90 && n <= 97) ||
+		(n >= 100 && n <= 107)
+}
+
+// ansi16Param returns the SGR parameter for the basic color idx (0-15).
+func ansi16Param(idx int, bg bool) int {
+	offset := 30
+	if bg {
+		offset = 40
+	}
+	if idx >= 8 {
+		return offset + 60 + idx - 8
+	}
+
+	return offset + idx
+}
+
+// ansi256ToRGB returns the RGB value of a 256 palette index.
+func ansi256ToRGB(n int) [3]uint8 {
+	switch {
+	case n < 16:
+		return ansiPalette[n]
+	case n < 232:
+		n -= 16
+		steps := [6]uint8{0, 95, 135, 175, 215, 255}
+		return [3]uint8{steps[n/36], steps[(n/6)%6], steps[n%6]}
+	default:
+		v := uint8(8 + (n-232)*10)
+		return [3]uint8{v, v, v}
+	}
+}
+
+// rgbTo256 returns the closest 256 palette index for the given RGB value.
+func rgbTo256(rgb [3]uint8) int {
+	cube := func(v uint8) int {
+		if v < 48 {
+			return 0
+		}
+		if v < 115 {
+			return 1
+		}
+		return (int(v) - 35) / 40
+	}
+	r, g, b := cube(rgb[0]), cube(rgb[1]), cube(rgb[2])
+	cubeIdx := 16 + 36*r + 6*g + b
+
+	avg := (int(rgb[0]) + int(rgb[1]) + int(rgb[2])) / 3
+	grayIdx := 232 + (avg-3)/10
+	if avg > 238 {
+		grayIdx = 255
+	} else if avg < 8 {
+		grayIdx = 232
+	}
+
+	if colorDistance(rgb, ansi256ToRGB(grayIdx)) < colorDistance(rgb, ansi256ToRGB(cubeIdx)) {
+		return grayIdx
+	}
+
+	return cubeIdx
+}
+
+// rgbTo16 returns the closest basic color index (0-15) for the given RGB
+// value.
+func rgbTo16(rgb [3]uint8) int {
+	best, bestDist := 0, -1
+	for i, c := range ansiPalette {
+		if d := colorDistance(rgb, c); bestDist < 0 || d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+
+	return best
+}
+
+// colorDistance returns the squared euclidean distance between two colors.
+func colorDistance(a, b [3]uint8) int {
+	dr := int(a[0]) - int(b[0])
+	dg := int(a[1]) - int(b[1])
+	db := int(a[2]) - int(b[2])
+
+	return dr*dr + dg*dg + db*db
+}
+
+// RGB returns a style with a 24-bit foreground color, degraded when
+// rendering on terminals with a lower color profile.
+func RGB(r, g, b uint8) Style {
+	return Style{Fg: Color{kind: colorRGB, r: r, g: g, b: b}}
+}
+
+// BgRGB returns a style with a 24-bit background color.
+func BgRGB(r, g, b uint8) Style {
+	return Style{Bg: Color{kind: colorRGB, r: r, g: g, b: b}}
+}
+
+// Color256 returns a style with a foreground color from the 256 colors
+// palette.
+func Color256(n uint8) Style {
+	return Style{Fg: Color{kind: colorANSI256, r: n}}
+}
+
+// BgColor256 returns a style with a background color from the 256 colors
+// palette.
+func BgColor256(n uint8) Style {
+	return Style{Bg: Color{kind: colorANSI256, r: n}}
+}
+
+// Hex returns a style with a 24-bit foreground color from a hex string like
+// "#ff8800" or "#f80". It returns the zero style (no color) if s is not a
+// valid hex color.
+func Hex(s string) Style {
+	rgb, ok := parseHex(s)
+	if !ok {
+		return Style{}
+	}
+
+	return RGB(rgb[0], rgb[1], rgb[2])
+}
+
+// BgHex returns a style with a 24-bit background color from a hex string
+// like "#ff8800" or "#f80". It returns the zero style (no color) if s is not
+// a valid hex color.
+func BgHex(s string) Style {
+	rgb, ok := parseHex(s)
+	if !ok {
+		return Style{}
+	}
+
+	return BgRGB(rgb[0], rgb[1], rgb[2])
+}
+
+// parseHex parses a hex color, with or without the leading "#".
+func parseHex(s string) ([3]uint8, bool) {
+	var rgb [3]uint8
+	s = strings.TrimPrefix(s, "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return rgb, false
+	}
+
+	for i := range rgb {
+		n, err := strconv.ParseUint(s[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return rgb, false
+		}
+		rgb[i] = uint8(n)
+	}
+
+	return rgb, true
+}
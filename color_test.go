@@ -0,0 +1,108 @@
+package rotato
+
+import "testing"
+
+func TestDetectColorProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		noColor   string
+		force     string
+		term      string
+		colorterm string
+		want      ColorProfile
+	}{
+		{name: "NO_COLOR", noColor: "1", term: "xterm-256color", want: ProfileNoColor},
+		{name: "Dumb terminal", term: "dumb", want: ProfileNoColor},
+		{name: "Basic terminal", term: "xterm", want: ProfileANSI},
+		{name: "256 colors", term: "xterm-256color", want: ProfileANSI256},
+		{name: "COLORTERM truecolor", term: "xterm-256color", colorterm: "truecolor", want: ProfileTrueColor},
+		{name: "FORCE_COLOR disabled", force: "0", term: "xterm-256color", want: ProfileNoColor},
+		{name: "FORCE_COLOR on dumb", force: "1", term: "dumb", want: ProfileANSI},
+		{name: "FORCE_COLOR level 3", force: "3", term: "xterm", want: ProfileTrueColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("FORCE_COLOR", tt.force)
+			t.Setenv("TERM", tt.term)
+			t.Setenv("COLORTERM", tt.colorterm)
+			if got := DetectColorProfile(); got != tt.want {
+				t.Errorf("DetectColorProfile() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDegrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		profile ColorProfile
+		want    string
+	}{
+		{
+			name:    "Truecolor untouched",
+			input:   "\x1b[38;2;255;136;0mHi",
+			profile: ProfileTrueColor,
+			want:    "\x1b[38;2;255;136;0mHi",
+		},
+		{
+			name:    "Truecolor to 256",
+			input:   "\x1b[38;2;255;136;0mHi",
+			profile: ProfileANSI256,
+			want:    "\x1b[38;5;208mHi",
+		},
+		{
+			name:    "256 to 16",
+			input:   ColorBrightOrange.String() + "Hi",
+			profile: ProfileANSI,
+			want:    "\x1b[33mHi",
+		},
+		{
+			name:    "Background truecolor to 16",
+			input:   "\x1b[48;2;0;0;0mHi",
+			profile: ProfileANSI,
+			want:    "\x1b[40mHi",
+		},
+		{
+			name:    "No color keeps styles",
+			input:   ColorRed.String() + ColorStyleBold.String() + "Hi" + ColorReset,
+			profile: ProfileNoColor,
+			want:    ColorStyleBold.String() + "Hi" + ColorReset,
+		},
+		{
+			name:    "No color mixed params",
+			input:   "\x1b[1;38;5;214mHi",
+			profile: ProfileNoColor,
+			want:    "\x1b[1mHi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := degrade(tt.input, tt.profile); got != tt.want {
+				t.Errorf("degrade(%q) = %q; want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "#ff8800", want: "\x1b[38;2;255;136;0m"},
+		{input: "FF8800", want: "\x1b[38;2;255;136;0m"},
+		{input: "#f80", want: "\x1b[38;2;255;136;0m"},
+		{input: "#ff88", want: ""},
+		{input: "#gg8800", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := Hex(tt.input).String(); got != tt.want {
+			t.Errorf("Hex(%q) = %q; want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package rotato
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Config represents the spinner settings loaded from a JSON or TOML file.
+// Empty fields are left untouched when the options are applied.
+type Config struct {
+	Theme          string   `json:"theme"`           // Built-in theme name
+	SymbolSet      string   `json:"symbol_set"`      // Registered symbol set name
+	Symbols        []string `json:"symbols"`         // Spinner symbols
+	Frequency      string   `json:"frequency"`       // Animation frequency, like "120ms"
+	Message        string   `json:"message"`         // Spinner message
+	Prefix         string   `json:"prefix"`          // Prefix message
+	Delimiter      string   `json:"delimiter"`       // Delimiter between prefix and spinner
+	SpinnerColor   string   `json:"spinner_color"`   // Spinner color, see ParseStyle
+	PrefixColor    string   `json:"prefix_color"`    // Prefix color
+	DelimiterColor string   `json:"delimiter_color"` // Delimiter color
+	MessageColor   string   `json:"message_color"`   // Message color
+	DoneSymbol     string   `json:"done_symbol"`     // Done symbol
+	DoneColor      string   `json:"done_color"`      // Done message color
+	FailSymbol     string   `json:"fail_symbol"`     // Fail symbol
+	FailColor      string   `json:"fail_color"`      // Fail message color
+}
+
+// FieldError represents an invalid value in a config field.
+type FieldError struct {
+	Field string // Config field name, as written in the file
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("rotato: config field %q: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// LoadConfig reads and validates the spinner config at path. Files with a
+// ".toml" extension are parsed as TOML, anything else as JSON.
+func LoadConfig(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("rotato: %w", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return ReadConfigTOML(f)
+	}
+
+	return ReadConfig(f)
+}
+
+// ReadConfig reads and validates a JSON spinner config from r.
+func ReadConfig(r io.Reader) (*Config, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	c := &Config{}
+	if err := dec.Decode(c); err != nil {
+		return nil, jsonFieldError(err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// ReadConfigTOML reads and validates a TOML spinner config from r. Only
+// top-level keys with string, number, boolean and array values are
+// supported.
+func ReadConfigTOML(r io.Reader) (*Config, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("rotato: %w", err)
+	}
+	values, err := parseTOML(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("rotato: %w", err)
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("rotato: %w", err)
+	}
+
+	return ReadConfig(bytes.NewReader(data))
+}
+
+// Validate checks every config field and returns the invalid ones as
+// *FieldError values joined together.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Theme != "" {
+		if _, ok := LookupTheme(c.Theme); !ok {
+			errs = append(errs, &FieldError{"theme", fmt.Errorf("unknown theme %q", c.Theme)})
+		}
+	}
+	if c.SymbolSet != "" {
+		if _, ok := SymbolSet(c.SymbolSet); !ok {
+			errs = append(errs, &FieldError{"symbol_set", fmt.Errorf("unknown symbol set %q", c.SymbolSet)})
+		}
+	}
+	if c.Frequency != "" {
+		if d, err := time.ParseDuration(c.Frequency); err != nil {
+			errs = append(errs, &FieldError{"frequency", err})
+		} else if d <= 0 {
+			errs = append(errs, &FieldError{"frequency", errors.New("must be positive")})
+		}
+	}
+	for _, f := range c.styleFields() {
+		if _, err := ParseStyle(*f.value); err != nil {
+			errs = append(errs, &FieldError{f.name, err})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Options returns the spinner options described by the config, invalid
+// fields are skipped.
+func (c *Config) Options() []Option {
+	var opts []Option
+	if t, ok := LookupTheme(c.Theme); ok {
+		opts = append(opts, WithTheme(t))
+	}
+	if c.SymbolSet != "" {
+		opts = append(opts, WithSymbolSet(c.SymbolSet))
+	}
+	if len(c.Symbols) > 0 {
+		opts = append(opts, WithSymbols(c.Symbols...))
+	}
+	if d, err := time.ParseDuration(c.Frequency); err == nil && d > 0 {
+		opts = append(opts, WithSpinnerFrequency(d))
+	}
+
+	strs := []struct {
+		value string
+		opt   func(string) Option
+	}{
+		{c.Message, WithMesg},
+		{c.Prefix, WithPrefix},
+		{c.Delimiter, WithDelimiter},
+		{c.DoneSymbol, WithDoneSymbol},
+		{c.FailSymbol, WithFailSymbol},
+	}
+	for _, s := range strs {
+		if s.value != "" {
+			opts = append(opts, s.opt(s.value))
+		}
+	}
+
+	for _, f := range c.styleFields() {
+		if *f.value == "" {
+			continue
+		}
+		if st, err := ParseStyle(*f.value); err == nil {
+			opts = append(opts, f.opt(st))
+		}
+	}
+
+	return opts
+}
+
+// configStyleField describes a config field holding a style.
+type configStyleField struct {
+	name  string
+	value *string
+	opt   func(...Style) Option
+}
+
+// styleFields returns the config fields holding a style.
+func (c *Config) styleFields() []configStyleField {
+	return []configStyleField{
+		{"spinner_color", &c.SpinnerColor, WithSpinnerColor},
+		{"prefix_color", &c.PrefixColor, WithPrefixColor},
+		{"delimiter_color", &c.DelimiterColor, WithDelimiterColor},
+		{"message_color", &c.MessageColor, WithMesgColor},
+		{"done_color", &c.DoneColor, WithDoneColorMesg},
+		{"fail_color", &c.FailColor, WithFailColorMesg},
+	}
+}
+
+// jsonFieldError wraps JSON decoding errors that refer to a single field
+// in a *FieldError.
+func jsonFieldError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return &FieldError{typeErr.Field, fmt.Errorf("expected %s, got %s", typeErr.Type, typeErr.Value)}
+	}
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return &FieldError{strings.Trim(field, `"`), errors.New("unknown field")}
+	}
+
+	return fmt.Errorf("rotato: %w", err)
+}
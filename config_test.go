@@ -0,0 +1,90 @@
+package rotato
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadConfig(t *testing.T) {
+	c, err := ReadConfig(strings.NewReader(`{
+		"theme": "ocean",
+		"symbols": ["a", "b"],
+		"frequency": "120ms",
+		"prefix": "Repo",
+		"spinner_color": "bold #ff8800"
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sp := New(c.Options()...)
+	if got := strings.Join(sp.Symbols(), ""); got != "ab" {
+		t.Errorf("expected config symbols, got %q", got)
+	}
+	if sp.frequency != 120*time.Millisecond {
+		t.Errorf("expected frequency 120ms, got %v", sp.frequency)
+	}
+	if want := Hex("#ff8800").Add(ColorStyleBold); sp.spinnerColor != want {
+		t.Errorf("expected spinner color %v, got %v", want, sp.spinnerColor)
+	}
+	if sp.prefixColor != ThemeOcean.PrefixColor {
+		t.Error("expected theme prefix color to be applied")
+	}
+}
+
+func TestReadConfigTOML(t *testing.T) {
+	c, err := ReadConfigTOML(strings.NewReader(`
+# spinner settings
+message = "Syncing..." # inline comment
+symbols = [
+  "-", '\',
+  "|", "/",
+]
+done_symbol = "✔"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Message != "Syncing..." {
+		t.Errorf("expected message, got %q", c.Message)
+	}
+	if got := strings.Join(c.Symbols, ""); got != `-\|/` {
+		t.Errorf("expected symbols, got %q", got)
+	}
+	if c.DoneSymbol != "✔" {
+		t.Errorf("expected escaped done symbol, got %q", c.DoneSymbol)
+	}
+}
+
+func TestConfigFieldErrors(t *testing.T) {
+	_, err := ReadConfig(strings.NewReader(`{
+		"theme": "nope",
+		"frequency": "fast",
+		"message_color": "#12"
+	}`))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+
+	fields := map[string]bool{}
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fe *FieldError
+		if !errors.As(e, &fe) {
+			t.Fatalf("expected *FieldError, got %T", e)
+		}
+		fields[fe.Field] = true
+	}
+	for _, f := range []string{"theme", "frequency", "message_color"} {
+		if !fields[f] {
+			t.Errorf("expected error for field %q, got %v", f, err)
+		}
+	}
+
+	_, err = ReadConfigTOML(strings.NewReader(`frequency = 100`))
+	var fe *FieldError
+	if !errors.As(err, &fe) || fe.Field != "frequency" {
+		t.Errorf("expected type error for field frequency, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package rotato
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// shimmerStep is the time the shimmer highlight takes to move one column.
+	shimmerStep = 60 * time.Millisecond
+
+	// shimmerBand is the width of the shimmer highlight.
+	shimmerBand = 3
+
+	// typewriterStep is the time the typewriter effect takes to reveal one
+	// character.
+	typewriterStep = 40 * time.Millisecond
+)
+
+// shimmerStyle is the style of the shimmer highlight.
+var shimmerStyle = ColorBrightWhite.Add(ColorStyleBold)
+
+// MesgEffect represents an animated effect applied to the spinner message.
+type MesgEffect int
+
+const (
+	// EffectNone renders the message as is.
+	EffectNone MesgEffect = iota
+	// EffectShimmer sweeps a highlight across the message.
+	EffectShimmer
+	// EffectTypewriter reveals the message one character at a time, every
+	// time it changes.
+	EffectTypewriter
+)
+
+// WithMesgEffect returns an option function that sets the message effect.
+// Effects are disabled in non-interactive and reduced motion modes.
+func WithMesgEffect(e MesgEffect) Option {
+	return func(sp *Spinner) {
+		sp.mesgEffect = e
+	}
+}
+
+// WithReducedMotion returns an option function that disables message
+// effects and marquee scrolling, long messages are truncated instead.
+func WithReducedMotion(b bool) Option {
+	return func(sp *Spinner) {
+		sp.reducedMotion = b
+	}
+}
+
+// applyEffect applies the message effect to the given uncolored message,
+// the caller wraps the result in the message color.
+func (sp *Spinner) applyEffect(mesg string) string {
+	if sp.mesgEffect == EffectNone || sp.reducedMotion || !isInteractive(sp) {
+		return mesg
+	}
+
+	elapsed := sp.messageElapsed()
+	switch sp.mesgEffect {
+	case EffectShimmer:
+		return shimmer(removeANSI(mesg), int(elapsed/shimmerStep), sp.messageColor)
+	case EffectTypewriter:
+		return typewriter(mesg, int(elapsed/typewriterStep))
+	}
+
+	return mesg
+}
+
+// shimmer highlights the band of runes at the given position of a cycle
+// that sweeps across s and pauses off-screen.
+func shimmer(s string, pos int, base Style) string {
+	runes := []rune(s)
+	cycle := len(runes) + shimmerBand*4
+	start := pos%cycle - shimmerBand
+	if start+shimmerBand <= 0 || start >= len(runes) {
+		return s
+	}
+
+	from, to := max(start, 0), min(start+shimmerBand, len(runes))
+	var sb strings.Builder
+	sb.WriteString(string(runes[:from]))
+	sb.WriteString(ColorReset + shimmerStyle.String())
+	sb.WriteString(string(runes[from:to]))
+	sb.WriteString(ColorReset + base.String())
+	sb.WriteString(string(runes[to:]))
+
+	return sb.String()
+}
+
+// typewriter returns the first n runes of s, skipping escape sequences.
+func typewriter(s string, n int) string {
+	plain := removeANSI(s)
+	runes := []rune(plain)
+	if n >= len(runes) {
+		return s
+	}
+
+	return string(runes[:n])
+}
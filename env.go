@@ -0,0 +1,80 @@
+package rotato
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables that override the spinner options passed to New.
+const (
+	envSymbols   = "ROTATO_SYMBOLS"        // Symbol set name or comma separated list of frames
+	envFrequency = "ROTATO_FREQUENCY"      // Animation frequency, like "120ms"
+	envColor     = "ROTATO_COLOR"          // Spinner color, see ParseStyle
+	envDisable   = "ROTATO_DISABLE"        // Disable all spinner output
+	envMode      = "ROTATO_MODE"           // Output mode: plain, json or tty
+	envReduced   = "ROTATO_REDUCED_MOTION" // Disable message effects and marquee
+)
+
+// applyEnv applies the `ROTATO_*` environment variables to the spinner.
+// Invalid values are ignored.
+func applyEnv(sp *Spinner) {
+	if s := os.Getenv(envSymbols); s != "" {
+		if _, ok := LookupAnimation(s); ok {
+			WithSymbolSet(s)(sp)
+		} else {
+			WithSymbols(strings.Split(s, ",")...)(sp)
+		}
+	}
+	if d, err := time.ParseDuration(os.Getenv(envFrequency)); err == nil && d > 0 {
+		WithSpinnerFrequency(d)(sp)
+	}
+	if s := os.Getenv(envColor); s != "" {
+		if st, err := ParseStyle(s); err == nil {
+			WithSpinnerColor(st)(sp)
+		}
+	}
+
+	switch strings.ToLower(os.Getenv(envMode)) {
+	case "plain":
+		sp.mode = modePlain
+	case "json":
+		sp.mode = modeJSON
+	case "tty":
+		sp.mode = modeTTY
+	}
+
+	if reduced, _ := strconv.ParseBool(os.Getenv(envReduced)); reduced {
+		sp.reducedMotion = true
+	}
+
+	if disabled, _ := strconv.ParseBool(os.Getenv(envDisable)); disabled {
+		sp.Writer = io.Discard
+		sp.mode = modePlain
+	}
+}
+
+// jsonEvent represents a spinner event written in JSON mode.
+type jsonEvent struct {
+	Time    time.Time `json:"time"`
+	Event   string    `json:"event"`
+	Prefix  string    `json:"prefix,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// emitJSON writes a spinner event as a JSON line.
+func (sp *Spinner) emitJSON(event, mesg string) {
+	sp.prefixMu.RLock()
+	e := jsonEvent{
+		Time:    sp.clock.Now(),
+		Event:   event,
+		Prefix:  sp.prefixMesg,
+		Message: removeANSI(mesg),
+	}
+	sp.prefixMu.RUnlock()
+
+	_ = json.NewEncoder(sp.Writer).Encode(e)
+}
@@ -0,0 +1,103 @@
+package rotato
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnvOverrides(t *testing.T) {
+	t.Setenv(envSymbols, "a,b,c")
+	t.Setenv(envFrequency, "250ms")
+	t.Setenv(envColor, "brightred")
+
+	sp := New(WithSymbolsDots(), WithSpinnerFrequency(time.Second), WithSpinnerColor(ColorBlue))
+	if got := strings.Join(sp.Symbols(), ""); got != "abc" {
+		t.Errorf("expected symbols from env, got %q", got)
+	}
+	if sp.frequency != 250*time.Millisecond {
+		t.Errorf("expected frequency from env, got %v", sp.frequency)
+	}
+	if sp.spinnerColor != ColorBrightRed {
+		t.Errorf("expected spinner color from env, got %v", sp.spinnerColor)
+	}
+}
+
+func TestEnvDisable(t *testing.T) {
+	t.Setenv(envDisable, "1")
+
+	var buf bytes.Buffer
+	sp := New(WithWriter(&buf))
+	sp.Start()
+	sp.UpdateMesg("Working")
+	sp.Done("Done")
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
+
+func TestEnvModeJSON(t *testing.T) {
+	t.Setenv(envMode, "json")
+
+	var buf bytes.Buffer
+	sp := New(WithWriter(&buf), WithPrefix("Repo"), WithMesg("Syncing"))
+	sp.Start()
+	sp.UpdateMesg("Pulling")
+	sp.Done("Synced")
+
+	var events []jsonEvent
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		var e jsonEvent
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", sc.Text(), err)
+		}
+		events = append(events, e)
+	}
+
+	want := []string{"start:Syncing", "update:Pulling", "done:Synced"}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d: %q", len(want), len(events), buf.String())
+	}
+	for i, e := range events {
+		if got := e.Event + ":" + e.Message; got != want[i] || e.Prefix != "Repo" {
+			t.Errorf("event %d: expected %q with prefix, got %q (%q)", i, want[i], got, e.Prefix)
+		}
+	}
+}
+
+func TestEnvFrequencyPinned(t *testing.T) {
+	t.Setenv(envFrequency, "1s")
+	t.Setenv(envSymbols, "barblock")
+
+	sp := New()
+	if sp.frequency != time.Second {
+		t.Errorf("expected frequency from env, got %v", sp.frequency)
+	}
+	sp.currentFrame(len(sp.frames) - 1)
+	if got := sp.frameDuration(); got != time.Second {
+		t.Errorf("expected env frequency to replace the hold duration, got %v", got)
+	}
+
+	sp.UpdateSymbols(WithSymbolsClock())
+	if sp.frequency != time.Second {
+		t.Errorf("expected env frequency to survive UpdateSymbols, got %v", sp.frequency)
+	}
+}
+
+func TestEnvSymbolSetInterval(t *testing.T) {
+	t.Setenv(envSymbols, "barblock")
+
+	sp := New()
+	a, _ := LookupAnimation("barblock")
+	if sp.frequency != a.Interval {
+		t.Errorf("expected symbol set interval %v, got %v", a.Interval, sp.frequency)
+	}
+	sp.currentFrame(len(sp.frames) - 1)
+	if got := sp.frameDuration(); got != holdDuration {
+		t.Errorf("expected symbol set hold duration, got %v", got)
+	}
+}
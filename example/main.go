@@ -13,58 +13,9 @@ var (
 	demoAll            bool
 	nonInteractiveFlag bool
 	simpleDemo         bool
+	symbolSet          string
 )
 
-type rotatoSymbols struct {
-	s string
-	o rotato.Option
-}
-
-var allSymbols = []rotatoSymbols{
-	{s: "WithSymbolsBlock", o: rotato.WithSymbolsBlock()},
-	{s: "WithSymbolsBlockBar", o: rotato.WithSymbolsBarBlock()},
-	{s: "WithSymbolsBlockBar2", o: rotato.WithSymbolsBarBlock2()},
-	{s: "WithSymbolsBlockBar3", o: rotato.WithSymbolsBarBlock3()},
-	{s: "WithSymbolsBlockBar4", o: rotato.WithSymbolsBarBlock4()},
-	{s: "WithSymbolsBlockBar5", o: rotato.WithSymbolsBarBlock5()},
-	{s: "WithSymbolsBlockPretty", o: rotato.WithSymbolsBlockPretty()},
-	{s: "WithSymbolsDots", o: rotato.WithSymbolsDots()},
-	{s: "WithSymbolsDots2", o: rotato.WithSymbolsDots2()},
-	{s: "WithSymbolsDots3", o: rotato.WithSymbolsDots3()},
-	{s: "WithSymbolsDots4", o: rotato.WithSymbolsDots4()},
-	{s: "WithSymbolsDots5", o: rotato.WithSymbolsDots5()},
-	{s: "WithSymbolsLines", o: rotato.WithSymbolsLines()},
-	{s: "WithSymbolsWave", o: rotato.WithSymbolsWave()},
-	{s: "WithSymbolsGrow", o: rotato.WithSymbolsGrow()},
-	{s: "WithSymbolsGrowVert", o: rotato.WithSymbolsGrowVert()},
-	{s: "WithSymbolsMoon", o: rotato.WithSymbolsMoon()},
-	{s: "WithSymbolsPipe", o: rotato.WithSymbolsPipe()},
-	{s: "WithSymbolsPipe2", o: rotato.WithSymbolsPipe2()},
-	{s: "WithSymbolsSquare", o: rotato.WithSymbolsSquare()},
-	{s: "WithSymbolsSquare2", o: rotato.WithSymbolsSquare2()},
-	{s: "WithSymbolsClock", o: rotato.WithSymbolsClock()},
-	{s: "WithSymbolsDiamond", o: rotato.WithSymbolsDiamond()},
-	{s: "WithSymbolsDiamond2", o: rotato.WithSymbolsDiamond2()},
-	{s: "WithSymbolsPlusCross", o: rotato.WithSymbolsPlusCross()},
-	{s: "WithSymbolsArrows", o: rotato.WithSymbolsArrows()},
-	{s: "WithSymbolsArrows2", o: rotato.WithSymbolsArrows2()},
-	{s: "WithSymbolsArrows3", o: rotato.WithSymbolsArrows3()},
-	{s: "WithSymbolsArrows4", o: rotato.WithSymbolsArrows4()},
-	{s: "WithSymbolsCircles", o: rotato.WithSymbolsCircles()},
-	{s: "WithSymbolsCircles2", o: rotato.WithSymbolsCircles2()},
-	{s: "WithSymbolsCircles3", o: rotato.WithSymbolsCircles3()},
-	{s: "WithSymbolsCircles4", o: rotato.WithSymbolsCircles4()},
-	{s: "WithSymbolsCircles5", o: rotato.WithSymbolsCircles5()},
-	{s: "WithSymbolsCircles6", o: rotato.WithSymbolsCircles6()},
-	{s: "WithSymbolsCircles7", o: rotato.WithSymbolsCircles7()},
-	{s: "WithSymbolsBounce", o: rotato.WithSymbolsBounce()},
-	{s: "WithSymbolsBounceBall", o: rotato.WithSymbolsBounceBall()},
-	{s: "WithSymbolsToggle", o: rotato.WithSymbolsToggle()},
-	{s: "WithSymbolsToggle2", o: rotato.WithSymbolsToggle2()},
-	{s: "WithSymbolsToggle3", o: rotato.WithSymbolsToggle3()},
-	{s: "WithSymbolsLoading", o: rotato.WithSymbolsLoading()},
-}
-
 // randomString returns a random string of length n.
 //
 //nolint:gosec //example
@@ -79,19 +30,19 @@ func randomString(n int) string {
 	return string(b)
 }
 
-// showSymbols shows all registered symbols in the rotato package.
-func showSymbols() {
+// showSymbols shows the given registered symbol sets in the rotato package.
+func showSymbols(names ...string) {
 	maxLen := 0
-	for _, symbol := range allSymbols {
-		maxLen = max(maxLen, len(symbol.s))
+	for _, name := range names {
+		maxLen = max(maxLen, len(name))
 	}
 
-	exitMesg := rotato.ColorGray + rotato.ColorStyleItalic + "(Press Ctrl+C to exit)" + rotato.ColorReset
-	for _, symbol := range allSymbols {
+	exitMesg := rotato.ColorGray.Add(rotato.ColorStyleItalic).Render("(Press Ctrl+C to exit)")
+	for _, name := range names {
 		sp := rotato.New(
 			rotato.WithMesg(exitMesg),
-			rotato.WithPrefix(symbol.s+strings.Repeat(" ", maxLen-len(symbol.s))),
-			symbol.o,
+			rotato.WithPrefix(name+strings.Repeat(" ", maxLen-len(name))),
+			rotato.WithSymbolSet(name),
 		)
 		sp.Start()
 		time.Sleep(2 * time.Second)
@@ -122,7 +73,7 @@ func spConnection() {
 	r.Start()
 	time.Sleep(2 * time.Second)
 	// connected
-	r.UpdateSymbols(rotato.WithSymbols(rotato.ColorBrightGreen + "✓"))
+	r.UpdateSymbols(rotato.WithFrames(rotato.Frame{Symbol: "✓", Style: rotato.ColorBrightGreen}))
 	r.UpdateMesg("Connected!")
 	r.UpdateMesgColor(rotato.ColorBrightGreen, rotato.ColorStyleItalic)
 	// updating
@@ -160,7 +111,9 @@ func main() {
 
 	switch {
 	case demoAll:
-		showSymbols()
+		showSymbols(rotato.SymbolNames()...)
+	case symbolSet != "":
+		showSymbols(symbolSet)
 	case simpleDemo:
 		spSimple()
 		spConnection()
@@ -174,5 +127,6 @@ func init() {
 	flag.BoolVar(&simpleDemo, "demo", false, "show demo rotatos")
 	flag.BoolVar(&demoAll, "all", false, "show all rotatos")
 	flag.BoolVar(&nonInteractiveFlag, "ni", false, "term non-interactive mode")
+	flag.StringVar(&symbolSet, "symbols", "", "show the rotato with the given symbol set name")
 	flag.Parse()
 }
@@ -0,0 +1,112 @@
+package rotato
+
+import (
+	"strings"
+	"time"
+)
+
+// BouncingBlock returns an animation of a block of the given size bouncing
+// inside a bar of the given width.
+//
+//	"[===       ]", "[ ===      ]", ... "[       ===]", ... "[ ===      ]".
+func BouncingBlock(width, size int) Animation {
+	width = max(width, 1)
+	size = min(max(size, 1), width)
+
+	positions := pingPong(width - size + 1)
+	frames := make([]string, len(positions))
+	for i, pos := range positions {
+		frames[i] = "[" + strings.Repeat(" ", pos) + strings.Repeat("=", size) +
+			strings.Repeat(" ", width-size-pos) + "]"
+	}
+
+	return Animation{Name: "bouncingblock", Frames: frames, Interval: 80 * time.Millisecond}
+}
+
+// Scanner returns a KITT-style animation of a light with a fading trail
+// sweeping back and forth across the given width.
+//
+//	"█     ", "▓█    ", "▒▓█   ", "░▒▓█  ", ... "  █▓▒░", " █▓▒░ ".
+func Scanner(width int) Animation {
+	width = max(width, 1)
+	trail := []rune("▓▒░")
+
+	positions := pingPong(width)
+	frames := make([]string, len(positions))
+	for i, pos := range positions {
+		cells := []rune(strings.Repeat(" ", width))
+		// the trail follows the light, behind its direction of movement.
+		dir := -1
+		if i >= width {
+			dir = 1
+		}
+		for j, r := range trail {
+			if p := pos + dir*(j+1); p >= 0 && p < width {
+				cells[p] = r
+			}
+		}
+		cells[pos] = '█'
+		frames[i] = string(cells)
+	}
+
+	return Animation{Name: "scanner", Frames: frames, Interval: 60 * time.Millisecond}
+}
+
+// FillingBar returns an animation of a bar of the given number of cells
+// filling up with the fill glyph over the empty glyph, holding the last
+// frame.
+//
+//	FillingBar(4, "█", "░"): "░░░░", "█░░░", "██░░", "███░", "████".
+func FillingBar(cells int, fill, empty string) Animation {
+	cells = max(cells, 1)
+	frames := make([]string, cells+1)
+	for i := range frames {
+		frames[i] = strings.Repeat(fill, i) + strings.Repeat(empty, cells-i)
+	}
+	durations := make([]time.Duration, len(frames))
+	durations[len(durations)-1] = holdDuration
+
+	return Animation{
+		Name:      "fillingbar",
+		Frames:    frames,
+		Interval:  100 * time.Millisecond,
+		Durations: durations,
+	}
+}
+
+// Banner returns an animation of the given text scrolling through a window
+// of the given width, like a rotating banner.
+//
+//	Banner("rotato", 4): "rota", "otat", "tato", "ato ", ...
+func Banner(text string, width int) Animation {
+	width = max(width, 1)
+	runes := []rune(text + " ")
+	if len(runes) <= width {
+		runes = append(runes, []rune(strings.Repeat(" ", width-len(runes)+1))...)
+	}
+
+	frames := make([]string, len(runes))
+	for i := range frames {
+		window := make([]rune, width)
+		for j := range window {
+			window[j] = runes[(i+j)%len(runes)]
+		}
+		frames[i] = string(window)
+	}
+
+	return Animation{Name: "banner", Frames: frames, Interval: 150 * time.Millisecond}
+}
+
+// pingPong returns the positions 0..n-1 followed by n-2..1, so looping over
+// them bounces between both ends.
+func pingPong(n int) []int {
+	positions := make([]int, 0, max(2*n-2, 1))
+	for i := 0; i < n; i++ {
+		positions = append(positions, i)
+	}
+	for i := n - 2; i > 0; i-- {
+		positions = append(positions, i)
+	}
+
+	return positions
+}
@@ -0,0 +1,51 @@
+package rotato
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerators(t *testing.T) {
+	tests := []struct {
+		name  string
+		anim  Animation
+		width int
+		want  []string
+	}{
+		{
+			name:  "BouncingBlock",
+			anim:  BouncingBlock(4, 2),
+			width: 6,
+			want:  []string{"[==  ]", "[ == ]", "[  ==]", "[ == ]"},
+		},
+		{
+			name:  "Scanner",
+			anim:  Scanner(3),
+			width: 3,
+			want:  []string{"█  ", "▓█ ", "▒▓█", " █▓"},
+		},
+		{
+			name:  "FillingBar",
+			anim:  FillingBar(3, "#", "-"),
+			width: 3,
+			want:  []string{"---", "#--", "##-", "###"},
+		},
+		{
+			name:  "Banner",
+			anim:  Banner("abc", 2),
+			width: 2,
+			want:  []string{"ab", "bc", "c ", " a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.Join(tt.anim.Frames, ","); got != strings.Join(tt.want, ",") {
+				t.Errorf("expected frames %q, got %q", tt.want, tt.anim.Frames)
+			}
+			if got := tt.anim.Width(); got != tt.width {
+				t.Errorf("expected width %d, got %d", tt.width, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package rotato
+
+import "math"
+
+const (
+	// gradientSteps is the number of frames used to blend between two
+	// gradient colors.
+	gradientSteps = 12
+
+	// rainbowSteps is the number of frames of a full rainbow cycle.
+	rainbowSteps = 36
+)
+
+// WithSpinnerGradient returns an option function that makes the spinner
+// color cycle through a gradient between the foreground colors of the given
+// styles, changing on every frame.
+func WithSpinnerGradient(colors ...Style) Option {
+	return func(sp *Spinner) {
+		sp.spinnerColors = gradient(colors, gradientSteps)
+	}
+}
+
+// WithSpinnerRainbow returns an option function that makes the spinner color
+// cycle through the rainbow, changing on every frame.
+func WithSpinnerRainbow() Option {
+	return func(sp *Spinner) {
+		sp.spinnerColors = rainbow(rainbowSteps)
+	}
+}
+
+// gradient returns a cyclic gradient between the given colors, blending each
+// pair over the given number of steps.
+func gradient(colors []Style, steps int) []Style {
+	switch len(colors) {
+	case 0:
+		return nil
+	case 1:
+		return []Style{{Fg: colors[0].Fg}}
+	}
+
+	out := make([]Style, 0, len(colors)*steps)
+	for i, c := range colors {
+		next := colors[(i+1)%len(colors)]
+		r1, g1, b1 := c.Fg.RGB()
+		r2, g2, b2 := next.Fg.RGB()
+		for step := 0; step < steps; step++ {
+			t := float64(step) / float64(steps)
+			out = append(out, RGB(lerp(r1, r2, t), lerp(g1, g2, t), lerp(b1, b2, t)))
+		}
+	}
+
+	return out
+}
+
+// rainbow returns a full hue cycle in the given number of steps.
+func rainbow(steps int) []Style {
+	out := make([]Style, steps)
+	for i := range out {
+		out[i] = RGB(hueToRGB(float64(i) * 360 / float64(steps)))
+	}
+
+	return out
+}
+
+// lerp linearly interpolates between a and b.
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+}
+
+// hueToRGB converts a hue in degrees to a fully saturated RGB color.
+func hueToRGB(h float64) (r, g, b uint8) {
+	x := 1 - math.Abs(math.Mod(h/60, 2)-1)
+	var rf, gf, bf float64
+	switch {
+	case h < 60:
+		rf, gf, bf = 1, x, 0
+	case h < 120:
+		rf, gf, bf = x, 1, 0
+	case h < 180:
+		rf, gf, bf = 0, 1, x
+	case h < 240:
+		rf, gf, bf = 0, x, 1
+	case h < 300:
+		rf, gf, bf = x, 0, 1
+	default:
+		rf, gf, bf = 1, 0, x
+	}
+
+	return uint8(math.Round(rf * 255)), uint8(math.Round(gf * 255)), uint8(math.Round(bf * 255))
+}
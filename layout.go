@@ -0,0 +1,80 @@
+package rotato
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultTemplate is the default spinner line layout.
+const defaultTemplate = "{prefix}{delim}{spinner} {message}{suffix}"
+
+// WithTemplate returns an option function that sets the layout of the
+// spinner line. The template accepts the placeholders:
+//
+//	{prefix}  prefix message
+//	{delim}   delimiter, only rendered with a prefix
+//	{spinner} spinner frame, or the done/fail symbol
+//	{message} spinner message
+//	{suffix}  suffix message, preceded by a space
+//	{elapsed} time since the spinner started
+func WithTemplate(tmpl string) Option {
+	return func(sp *Spinner) {
+		sp.template = tmpl
+	}
+}
+
+// layout composes the spinner line from the template, with the given frame
+// and message.
+func (sp *Spinner) layout(frame, mesg string) string {
+	if sp.powerline != nil {
+		return sp.powerlineLayout(frame, mesg)
+	}
+
+	sp.prefixMu.RLock()
+	var prefix, del string
+	if sp.prefixMesg != "" {
+		prefix = sp.prefixColor.String() + sp.prefixMesg + ColorReset
+		del = sp.delimiterColor.String() + sp.delimiter + ColorReset
+	}
+	sp.prefixMu.RUnlock()
+
+	tmpl := sp.template
+	if tmpl == "" {
+		tmpl = defaultTemplate
+	}
+
+	r := strings.NewReplacer(
+		"{prefix}", prefix,
+		"{delim}", del,
+		"{spinner}", frame,
+		"{message}", mesg,
+		"{suffix}", sp.suffix(),
+		"{elapsed}", formatElapsed(sp.elapsed()),
+	)
+
+	return r.Replace(tmpl)
+}
+
+// elapsed returns the time since the spinner was started, or zero if it
+// was never started.
+func (sp *Spinner) elapsed() time.Duration {
+	if sp.startedAt.IsZero() {
+		return 0
+	}
+
+	return sp.clock.Now().Sub(sp.startedAt)
+}
+
+// formatElapsed formats an elapsed time as "4.2s" under a minute, "01:42"
+// under an hour and "1:01:42" otherwise.
+func formatElapsed(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	case d < time.Hour:
+		return fmt.Sprintf("%02d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+	}
+}
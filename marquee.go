@@ -0,0 +1,124 @@
+package rotato
+
+import (
+	"strings"
+	"time"
+)
+
+// marqueeGap separates the end of a scrolling message from its start.
+const marqueeGap = "   "
+
+// WithMarquee returns an option function that scrolls messages wider than
+// the terminal horizontally, moving one column every d, instead of
+// truncating them.
+func WithMarquee(d time.Duration) Option {
+	return func(sp *Spinner) {
+		sp.marquee = d
+	}
+}
+
+// WithWidth returns an option function that sets the line width used to fit
+// the message, instead of the detected terminal width.
+func WithWidth(n int) Option {
+	return func(sp *Spinner) {
+		sp.width = n
+	}
+}
+
+// lineWidth returns the number of columns available for the spinner line,
+// or 0 if unknown.
+func (sp *Spinner) lineWidth() int {
+	if sp.width > 0 {
+		return sp.width
+	}
+
+	return terminalWidth(sp.Writer)
+}
+
+// messageWidth returns the number of columns available for the message
+// next to the given frame, or -1 if unlimited.
+func (sp *Spinner) messageWidth(frame string) int {
+	w := sp.lineWidth()
+	if w <= 0 {
+		return -1
+	}
+	// measure the line with a one column message, which accounts for the
+	// message decorations, like powerline segments, and leaves the last
+	// column empty so the line never wraps.
+	w -= textWidth(sp.layout(frame, " "))
+	if status := sp.status(); status != "" {
+		w -= textWidth(status) + 1
+	}
+
+	return max(w, 0)
+}
+
+// fitMessage fits the message in the given width, scrolling it if marquee
+// is enabled or truncating it otherwise.
+func (sp *Spinner) fitMessage(mesg string, width int) string {
+	if width < 0 || textWidth(mesg) <= width {
+		return mesg
+	}
+
+	plain := removeANSI(mesg)
+	if sp.marquee <= 0 || sp.reducedMotion {
+		return truncateText(plain, width)
+	}
+	offset := int(sp.messageElapsed() / sp.marquee)
+
+	return scrollText(plain, offset, width)
+}
+
+// messageElapsed returns the time since the message was last changed, or
+// zero if the spinner was never started.
+func (sp *Spinner) messageElapsed() time.Duration {
+	if sp.messageAt.IsZero() {
+		return 0
+	}
+
+	return sp.clock.Now().Sub(sp.messageAt)
+}
+
+// truncateText truncates s to the given width, ending it with an ellipsis.
+func truncateText(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	w := 0
+	for _, r := range s {
+		rw := runeWidth(r)
+		if w+rw > width-1 {
+			break
+		}
+		sb.WriteRune(r)
+		w += rw
+	}
+	sb.WriteString("…")
+
+	return sb.String()
+}
+
+// scrollText returns the window of the given width of s, followed by a gap
+// and s again, starting offset runes in.
+func scrollText(s string, offset, width int) string {
+	runes := []rune(s + marqueeGap)
+	if width <= 0 || len(runes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	w := 0
+	for i := 0; ; i++ {
+		r := runes[(offset+i)%len(runes)]
+		rw := runeWidth(r)
+		if w+rw > width {
+			break
+		}
+		sb.WriteRune(r)
+		w += rw
+	}
+
+	return sb.String()
+}
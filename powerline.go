@@ -0,0 +1,81 @@
+package rotato
+
+import "strings"
+
+// Powerline represents a rendering style where the prefix, spinner and
+// message are drawn as segments with background colors, joined by a
+// separator glyph.
+type Powerline struct {
+	Separator string // Separator glyph, drawn with the previous segment background
+	Prefix    Style  // Prefix segment style
+	Spinner   Style  // Spinner segment style
+	Message   Style  // Message segment style
+}
+
+// DefaultPowerline is a powerline style using the Nerd Fonts/Powerline
+// arrow separator.
+var DefaultPowerline = Powerline{
+	Separator: "",
+	Prefix:    ColorBrightWhite.Add(BgColor256(25), ColorStyleBold),
+	Spinner:   ColorBrightWhite.Add(BgColor256(31)),
+	Message:   ColorBrightWhite.Add(BgColor256(238)),
+}
+
+// WithPowerline returns an option function that renders the spinner line as
+// powerline segments, replacing the template and delimiter. The prefix,
+// spinner and message colors, like frame styles, gradients and the done and
+// fail colors, are drawn over the segment styles.
+func WithPowerline(p Powerline) Option {
+	return func(sp *Spinner) {
+		sp.powerline = &p
+	}
+}
+
+// powerlineSegment represents a single segment of a powerline.
+type powerlineSegment struct {
+	text  string
+	style Style
+}
+
+// powerlineLayout composes the spinner line as powerline segments, with
+// the given styled frame and message.
+func (sp *Spinner) powerlineLayout(frame, mesg string) string {
+	p := sp.powerline
+	segments := make([]powerlineSegment, 0, 3)
+
+	sp.prefixMu.RLock()
+	if sp.prefixMesg != "" {
+		prefix := sp.prefixColor.String() + sp.prefixMesg + ColorReset
+		segments = append(segments, powerlineSegment{prefix, p.Prefix})
+	}
+	sp.prefixMu.RUnlock()
+
+	segments = append(segments, powerlineSegment{frame, p.Spinner})
+	if mesg != "" {
+		segments = append(segments, powerlineSegment{mesg, p.Message})
+	}
+
+	var sb strings.Builder
+	for i, seg := range segments {
+		sb.WriteString(seg.style.String() + " " + segmentText(seg) + " " + ColorReset)
+
+		sep := Style{Fg: seg.style.Bg}
+		if i+1 < len(segments) {
+			sep.Bg = segments[i+1].style.Bg
+		}
+		sb.WriteString(sep.Render(p.Separator))
+	}
+	sb.WriteString(sp.suffix())
+
+	return sb.String()
+}
+
+// segmentText returns the segment text with the segment style restored
+// after every reset, so the text colors keep the segment background.
+func segmentText(seg powerlineSegment) string {
+	if seg.style.IsZero() {
+		return seg.text
+	}
+
+	return strings.ReplaceAll(seg.text, ColorReset, ColorReset+seg.style.String())
+}
@@ -0,0 +1,206 @@
+package rotato
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// registry holds the named symbol sets.
+var registry = struct {
+	mu   sync.RWMutex
+	sets map[string]Animation
+}{
+	sets: map[string]Animation{
+		"default":     {Name: "default", Frames: defaultSymbols, Interval: 100 * time.Millisecond},
+		"block":       animationOf("block", WithSymbolsBlock()),
+		"barblock":    animationOf("barblock", WithSymbolsBarBlock()),
+		"barblock2":   animationOf("barblock2", WithSymbolsBarBlock2()),
+		"barblock3":   animationOf("barblock3", WithSymbolsBarBlock3()),
+		"barblock4":   animationOf("barblock4", WithSymbolsBarBlock4()),
+		"barblock5":   animationOf("barblock5", WithSymbolsBarBlock5()),
+		"barblock6":   animationOf("barblock6", WithSymbolsBarBlock6()),
+		"blockpretty": animationOf("blockpretty", WithSymbolsBlockPretty()),
+		"dots":        animationOf("dots", WithSymbolsDots()),
+		"dots2":       animationOf("dots2", WithSymbolsDots2()),
+		"dots3":       animationOf("dots3", WithSymbolsDots3()),
+		"dots4":       animationOf("dots4", WithSymbolsDots4()),
+		"dots5":       animationOf("dots5", WithSymbolsDots5()),
+		"lines":       animationOf("lines", WithSymbolsLines()),
+		"wave":        animationOf("wave", WithSymbolsWave()),
+		"grow":        animationOf("grow", WithSymbolsGrow()),
+		"growvert":    animationOf("growvert", WithSymbolsGrowVert()),
+		"moon":        animationOf("moon", WithSymbolsMoon()),
+		"pipe":        animationOf("pipe", WithSymbolsPipe()),
+		"pipe2":       animationOf("pipe2", WithSymbolsPipe2()),
+		"square":      animationOf("square", WithSymbolsSquare()),
+		"square2":     animationOf("square2", WithSymbolsSquare2()),
+		"clock":       animationOf("clock", WithSymbolsClock()),
+		"diamond":     animationOf("diamond", WithSymbolsDiamond()),
+		"diamond2":    animationOf("diamond2", WithSymbolsDiamond2()),
+		"pluscross":   animationOf("pluscross", WithSymbolsPlusCross()),
+		"arrows":      animationOf("arrows", WithSymbolsArrows()),
+		"arrows2":     animationOf("arrows2", WithSymbolsArrows2()),
+		"arrows3":     animationOf("arrows3", WithSymbolsArrows3()),
+		"arrows4":     animationOf("arrows4", WithSymbolsArrows4()),
+		"circles":     animationOf("circles", WithSymbolsCircles()),
+		"circles2":    animationOf("circles2", WithSymbolsCircles2()),
+		"circles3":    animationOf("circles3", WithSymbolsCircles3()),
+		"circles4":    animationOf("circles4", WithSymbolsCircles4()),
+		"circles5":    animationOf("circles5", WithSymbolsCircles5()),
+		"circles6":    animationOf("circles6", WithSymbolsCircles6()),
+		"circles7":    animationOf("circles7", WithSymbolsCircles7()),
+		"bounce":      animationOf("bounce", WithSymbolsBounce()),
+		"bounceball":  animationOf("bounceball", WithSymbolsBounceBall()),
+		"toggle":      animationOf("toggle", WithSymbolsToggle()),
+		"toggle2":     animationOf("toggle2", WithSymbolsToggle2()),
+		"toggle3":     animationOf("toggle3", WithSymbolsToggle3()),
+		"loading":     animationOf("loading", WithSymbolsLoading()),
+	},
+}
+
+// SymbolSet returns a copy of the symbol set registered with the given name.
+func SymbolSet(name string) ([]string, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	a, ok := registry.sets[name]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]string(nil), a.Frames...), true
+}
+
+// LookupAnimation returns a copy of the animation registered with the given
+// name.
+func LookupAnimation(name string) (Animation, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	a, ok := registry.sets[name]
+	a.Frames = append([]string(nil), a.Frames...)
+	a.Durations = append([]time.Duration(nil), a.Durations...)
+
+	return a, ok
+}
+
+// SymbolNames returns the sorted names of the registered symbol sets.
+func SymbolNames() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	names := make([]string, 0, len(registry.sets))
+	for name := range registry.sets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// RegisterSymbols registers a symbol set with the given name, replacing any
+// set already registered with that name.
+func RegisterSymbols(name string, frames ...string) error {
+	return RegisterAnimation(Animation{Name: name, Frames: frames})
+}
+
+// RegisterAnimation registers an animation by its name, replacing any set
+// already registered with that name.
+func RegisterAnimation(a Animation) error {
+	return registerSets(map[string]Animation{a.Name: a})
+}
+
+// builtins holds the names of the built-in symbol sets.
+var builtins = func() map[string]bool {
+	names := make(map[string]bool, len(registry.sets))
+	for name := range registry.sets {
+		names[name] = true
+	}
+
+	return names
+}()
+
+// RegisterSpinnersJSON registers the spinners from r, in the cli-spinners
+// `spinners.json` format (name → {interval, frames}), alongside the built-in
+// sets and returns their sorted names. Each name is prefixed with prefix,
+// like "cli-dots", and names clashing with a built-in set are an error.
+// Each spinner interval, in milliseconds, is used as the frequency when the
+// set is selected with WithSymbolSet.
+func RegisterSpinnersJSON(r io.Reader, prefix string) ([]string, error) {
+	var spinners map[string]struct {
+		Interval int      `json:"interval"`
+		Frames   []string `json:"frames"`
+	}
+	if err := json.NewDecoder(r).Decode(&spinners); err != nil {
+		return nil, fmt.Errorf("rotato: decoding spinners: %w", err)
+	}
+
+	sets := make(map[string]Animation, len(spinners))
+	names := make([]string, 0, len(spinners))
+	var clashes []string
+	for name, sp := range spinners {
+		name = prefix + name
+		if builtins[name] {
+			clashes = append(clashes, name)
+			continue
+		}
+		if sp.Interval < 0 {
+			return nil, fmt.Errorf("rotato: spinner %q has a negative interval", name)
+		}
+		sets[name] = Animation{
+			Name:     name,
+			Frames:   sp.Frames,
+			Interval: time.Duration(sp.Interval) * time.Millisecond,
+		}
+		names = append(names, name)
+	}
+	if len(clashes) > 0 {
+		sort.Strings(clashes)
+		return nil, fmt.Errorf("rotato: spinners %q clash with built-in sets, use a name prefix", clashes)
+	}
+	if err := registerSets(sets); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// registerSets validates and registers the given animations, either all or
+// none of them.
+func registerSets(sets map[string]Animation) error {
+	for name, a := range sets {
+		if name == "" {
+			return errors.New("rotato: symbol set name is empty")
+		}
+		if len(a.Frames) == 0 {
+			return fmt.Errorf("rotato: symbol set %q has no frames", name)
+		}
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for name, a := range sets {
+		a.Frames = append([]string(nil), a.Frames...)
+		a.Durations = append([]time.Duration(nil), a.Durations...)
+		registry.sets[name] = a
+	}
+
+	return nil
+}
+
+// WithSymbolSet returns an option function that sets the spinner animation
+// to the one registered with the given name, see WithAnimation. Unknown
+// names are ignored.
+func WithSymbolSet(name string) Option {
+	return func(sp *Spinner) {
+		if a, ok := LookupAnimation(name); ok {
+			WithAnimation(a)(sp)
+		}
+	}
+}
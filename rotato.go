@@ -2,11 +2,9 @@
 package rotato
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"os"
-	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -17,44 +15,44 @@ const nbsp = "\u00A0"
 
 var (
 	// normal colors.
-	ColorBlack   = "\x1b[30m"
-	ColorBlue    = "\x1b[34m"
-	ColorCyan    = "\x1b[36m"
-	ColorGray    = "\x1b[90m"
-	ColorGreen   = "\x1b[32m"
-	ColorMagenta = "\x1b[95m"
-	ColorOrange  = "\x1b[33m"
-	ColorPurple  = "\x1b[35m"
-	ColorRed     = "\x1b[31m"
-	ColorWhite   = "\x1b[37m"
-	ColorYellow  = "\x1b[93m"
+	ColorBlack   = ansiColor(0)
+	ColorBlue    = ansiColor(4)
+	ColorCyan    = ansiColor(6)
+	ColorGray    = ansiColor(8)
+	ColorGreen   = ansiColor(2)
+	ColorMagenta = ansiColor(13)
+	ColorOrange  = ansiColor(3)
+	ColorPurple  = ansiColor(5)
+	ColorRed     = ansiColor(1)
+	ColorWhite   = ansiColor(7)
+	ColorYellow  = ansiColor(11)
 
 	// bright colors.
-	ColorBrightBlack   = "\x1b[90m"
-	ColorBrightBlue    = "\x1b[94m"
-	ColorBrightCyan    = "\x1b[96m"
-	ColorBrightGray    = "\x1b[37m"
-	ColorBrightGreen   = "\x1b[92m"
-	ColorBrightMagenta = "\x1b[95m"
-	ColorBrightOrange  = "\x1b[38;5;214m"
-	ColorBrightPurple  = "\x1b[38;5;135m"
-	ColorBrightRed     = "\x1b[91m"
-	ColorBrightWhite   = "\x1b[97m"
-	ColorBrightYellow  = "\x1b[93m"
+	ColorBrightBlack   = ansiColor(8)
+	ColorBrightBlue    = ansiColor(12)
+	ColorBrightCyan    = ansiColor(14)
+	ColorBrightGray    = ansiColor(7)
+	ColorBrightGreen   = ansiColor(10)
+	ColorBrightMagenta = ansiColor(13)
+	ColorBrightOrange  = Color256(214)
+	ColorBrightPurple  = Color256(135)
+	ColorBrightRed     = ansiColor(9)
+	ColorBrightWhite   = ansiColor(15)
+	ColorBrightYellow  = ansiColor(11)
 
 	// styles.
-	ColorStyleBold          = "\x1b[1m"
-	ColorStyleDim           = "\x1b[2m"
-	ColorStyleInverse       = "\x1b[7m"
-	ColorStyleItalic        = "\x1b[3m"
-	ColorStyleStrikethrough = "\x1b[9m"
-	ColorStyleUnderline     = "\x1b[4m"
-	ColorStyleBlink         = "\x1b[5m"
-
-	// reset.
-	ColorReset = "\x1b[0m"
+	ColorStyleBold          = Style{Bold: true}
+	ColorStyleDim           = Style{Dim: true}
+	ColorStyleInverse       = Style{Inverse: true}
+	ColorStyleItalic        = Style{Italic: true}
+	ColorStyleStrikethrough = Style{Strikethrough: true}
+	ColorStyleUnderline     = Style{Underline: true}
+	ColorStyleBlink         = Style{Blink: true}
 )
 
+// ColorReset is the escape sequence that resets all colors and styles.
+const ColorReset = "\x1b[0m"
+
 // WithMesg returns an option function that sets the spinner message.
 func WithMesg(s string) Option {
 	return func(sp *Spinner) {
@@ -64,9 +62,9 @@ func WithMesg(s string) Option {
 
 // WithMesgColor returns an option function that sets the spinner message
 // color.
-func WithMesgColor(color ...string) Option {
+func WithMesgColor(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.messageColor = strings.Join(color, "")
+		sp.messageColor = composeStyles(color)
 	}
 }
 
@@ -79,9 +77,9 @@ func WithPrefix(prefix string) Option {
 
 // WithPrefixColor returns an option function that sets the spinner color
 // prefix.
-func WithPrefixColor(color ...string) Option {
+func WithPrefixColor(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.prefixColor = strings.Join(color, "")
+		sp.prefixColor = composeStyles(color)
 	}
 }
 
@@ -94,9 +92,9 @@ func WithDoneSymbol(symbol string) Option {
 
 // WithDoneColorMesg returns an option function that sets the done message
 // color.
-func WithDoneColorMesg(color ...string) Option {
+func WithDoneColorMesg(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.doneMessageColor = strings.Join(color, "")
+		sp.doneMessageColor = composeStyles(color)
 	}
 }
 
@@ -109,23 +107,27 @@ func WithFailSymbol(symbol string) Option {
 
 // WithFailColorMesg returns an option function that sets the fail message
 // color.
-func WithFailColorMesg(color ...string) Option {
+func WithFailColorMesg(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.failMessageColor = strings.Join(color, "")
+		sp.failMessageColor = composeStyles(color)
 	}
 }
 
 // WithSpinnerColor returns an option function that sets the spinner color.
-func WithSpinnerColor(color ...string) Option {
+func WithSpinnerColor(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.spinnerColor = strings.Join(color, "")
+		sp.spinnerColor = composeStyles(color)
+		sp.spinnerColors = nil
 	}
 }
 
-// WithSpinnerFrequency returns an option function that sets the spinner frequency.
+// WithSpinnerFrequency returns an option function that sets the spinner
+// frequency. It takes precedence over the interval and hold durations of
+// animations, see WithAnimation.
 func WithSpinnerFrequency(d time.Duration) Option {
 	return func(sp *Spinner) {
 		sp.frequency = d
+		sp.frequencyPinned = true
 	}
 }
 
@@ -138,9 +140,9 @@ func WithDelimiter(s string) Option {
 
 // WithDelimiterColor returns an option function that sets the spinner color
 // delimiter, only visible with `prefix`.
-func WithDelimiterColor(color ...string) Option {
+func WithDelimiterColor(color ...Style) Option {
 	return func(sp *Spinner) {
-		sp.delimiterColor = strings.Join(color, "")
+		sp.delimiterColor = composeStyles(color)
 	}
 }
 
@@ -156,52 +158,77 @@ type Option func(*Spinner)
 
 // Spinner represents a CLI spinner animation.
 type Spinner struct {
-	Writer           io.Writer     // Output writer
-	delimiter        string        // Delimiter between prefix and spinner symbol
-	delimiterColor   string        // Delimiter color
-	doneChan         chan struct{} // Channel for stopping the spinner
-	doneMessageColor string        // Done channel message color
-	doneSymbol       string        // Done channel symbol
-	failMessageColor string        // Fail message color
-	failSymbol       string        // Fail symbol
-	frame            string        // Current spinner frame
-	frameIdx         int           // Current spinner frame index
-	frequency        time.Duration // Spinner animation frequency
-	isActive         bool          // State of the spinner
-	message          string        // Spinner message
-	messageColor     string        // Spinner message color
-	messageUpdate    sync.RWMutex  // Mutex for message update
-	mu               *sync.RWMutex // Mutex for different spinner states
-	prefixColor      string        // Prefix message color
-	prefixMesg       string        // Prefix message
-	prefixMu         sync.RWMutex  // Synchronization mechanism for prefix updates.
-	spinnerColor     string        // Spinner color
-	symbols          []string      // Spinner symbols
+	Writer           io.Writer       // Output writer
+	animDurations    []time.Duration // Animation frame durations, ignored when the frequency is pinned
+	clock            Clock           // Time source for the animation
+	colorProfile     ColorProfile    // Color capability used when rendering
+	delimiter        string          // Delimiter between prefix and spinner symbol
+	delimiterColor   Style           // Delimiter color
+	doneChan         chan struct{}   // Channel for stopping the spinner
+	doneMessageColor Style           // Done channel message color
+	doneSymbol       string          // Done channel symbol
+	failMessageColor Style           // Fail message color
+	failSymbol       string          // Fail symbol
+	frame            string          // Current spinner frame
+	frameIdx         int             // Current spinner frame index
+	frames           []Frame         // Spinner frames
+	frequency        time.Duration   // Spinner animation frequency
+	frequencyPinned  bool            // Frequency set explicitly, animation intervals and durations are ignored
+	interrupted      bool            // Stopped by an interrupt signal
+	isActive         bool            // State of the spinner
+	marquee          time.Duration   // Time to scroll a long message by one column, zero truncates
+	message          string          // Spinner message
+	messageColor     Style           // Spinner message color
+	messageAt        time.Time       // Time the message was last changed
+	messageUpdate    sync.RWMutex    // Mutex for message update
+	mesgEffect       MesgEffect      // Message effect
+	mode             outputMode      // Output mode
+	mu               *sync.RWMutex   // Mutex for different spinner states
+	onInterrupt      func(os.Signal) // Interrupt handler, nil re-raises the signal
+	playback         Playback        // Order in which the frames are played
+	powerline        *Powerline      // Powerline rendering style, nil uses the template
+	prefixColor      Style           // Prefix message color
+	prefixMesg       string          // Prefix message
+	prefixMu         sync.RWMutex    // Synchronization mechanism for prefix updates.
+	reducedMotion    bool            // Disables message effects and marquee
+	runMode          outputMode      // Output mode resolved at Start, kept until the next Start
+	segmentMu        sync.RWMutex    // Synchronization mechanism for suffix and status updates
+	spinnerColor     Style           // Spinner color
+	spinnerColors    []Style         // Spinner colors cycled per frame
+	startedAt        time.Time       // Time the spinner was started
+	statusColor      Style           // Status color
+	statusMesg       string          // Status, pinned to the right edge
+	stopInterrupt    func()          // Stops listening for interrupt signals
+	suffixColor      Style           // Suffix color
+	suffixMesg       string          // Suffix message
+	template         string          // Line layout, see WithTemplate
+	width            int             // Line width, zero detects the terminal width
 }
 
 // render displays the current frame and message of the spinner.
 func (sp *Spinner) render(current int) {
-	mesg := sp.currentMessage()
 	frameFormatted := sp.currentFrame(current)
-
-	if sp.prefixMesg != "" {
-		sp.parsePrefix(frameFormatted, mesg)
-		return
-	}
-	sp.display(fmt.Sprintf("%s %s", frameFormatted, mesg))
+	mesg := sp.currentMessage(sp.messageWidth(frameFormatted))
+	sp.display(sp.line(frameFormatted, mesg))
 }
 
 // Start starts the spinning animation in a goroutine.
 func (sp *Spinner) Start() {
-	if !isInteractive(sp) {
-		sp.mu.Lock()
-		defer sp.mu.Unlock()
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
 
-		if sp.isActive {
+	if sp.isActive {
+		return
+	}
+
+	sp.runMode = sp.detectMode()
+	sp.isActive = true
+	sp.listenInterrupt()
+	if !isInteractive(sp) {
+		if sp.mode == modeJSON {
+			sp.emitJSON("start", sp.message)
 			return
 		}
-
-		sp.isActive = true
 		// add prefix
 		if sp.prefixMesg != "" {
 			sp.message = fmt.Sprintf("%s%s%s", sp.prefixMesg, sp.delimiter, sp.message)
@@ -211,35 +238,24 @@ func (sp *Spinner) Start() {
 		return
 	}
 
-	hideCursor(sp.Writer)
-	sp.mu.Lock()
-	defer sp.mu.Unlock()
-
-	if sp.isActive {
-		return
-	}
-
-	sp.isActive = true
-	if isRedirected(sp.Writer) {
-		sp.render(0)
-		return
-	}
+	sp.hideCursor()
+	sp.startedAt = sp.clock.Now()
+	sp.messageAt = sp.startedAt
 
-	ticker := time.NewTicker(sp.frequency)
+	clock, d := sp.clock, sp.frequency
 	go func() {
-		defer ticker.Stop()
-
 		for i := 0; ; i++ {
 			select {
 			case <-sp.doneChan:
 				return
-			case <-ticker.C:
+			case <-clock.After(d):
 				sp.mu.Lock()
 				if !sp.isActive {
 					sp.mu.Unlock()
 					return
 				}
 				sp.render(i)
+				d = sp.frameDuration()
 				sp.mu.Unlock()
 			}
 		}
@@ -249,8 +265,14 @@ func (sp *Spinner) Start() {
 // Done stops the spinner animation.
 func (sp *Spinner) Done(mesg ...string) {
 	sp.stopSpinner()
+	if sp.mode == modeJSON {
+		sp.emitJSON("done", strings.Join(mesg, " "))
+		return
+	}
 	if len(mesg) == 0 {
-		fmt.Print(clearChars)
+		if isInteractive(sp) {
+			_, _ = fmt.Fprint(sp.Writer, clearChars)
+		}
 		return
 	}
 	sp.displayMessage(sp.doneSymbol, sp.doneMessageColor, mesg...)
@@ -265,27 +287,46 @@ func (sp *Spinner) Fail(mesg ...string) {
 	if len(mesg) == 0 {
 		mesg = append(mesg, "Failed")
 	}
+	if sp.mode == modeJSON {
+		sp.emitJSON("fail", strings.Join(mesg, " "))
+		return
+	}
 	sp.displayMessage(sp.failSymbol, sp.failMessageColor, mesg...)
 }
 
 // Symbols returns the spinner symbols.
 func (sp *Spinner) Symbols() []string {
-	return sp.symbols
+	symbols := make([]string, len(sp.frames))
+	for i, f := range sp.frames {
+		symbols[i] = f.Symbol
+	}
+
+	return symbols
 }
 
 // UpdateMesg changes the message shown next to the spinner.
 func (sp *Spinner) UpdateMesg(mesg string) {
 	sp.messageUpdate.Lock()
 	sp.message = mesg
+	sp.messageAt = sp.clock.Now()
 	sp.messageUpdate.Unlock()
-	if !isInteractive(sp) {
+	if sp.mode == modeJSON {
+		sp.emitJSON("update", mesg)
+		return
+	}
+	sp.mu.RLock()
+	interactive := isInteractive(sp)
+	sp.mu.RUnlock()
+	if !interactive {
 		_, _ = fmt.Fprintf(sp.Writer, "%s\n", mesg)
 	}
 }
 
 // UpdateMesgColor changes the color of the message.
-func (sp *Spinner) UpdateMesgColor(color ...string) {
-	sp.messageColor = strings.Join(color, "")
+func (sp *Spinner) UpdateMesgColor(color ...Style) {
+	sp.mu.Lock()
+	sp.messageColor = composeStyles(color)
+	sp.mu.Unlock()
 }
 
 // UpdatePrefix changes the prefix shown next to the spinner.
@@ -296,66 +337,91 @@ func (sp *Spinner) UpdatePrefix(mesg string) {
 }
 
 // UpdatePrefixColor changes the color of the prefix.
-func (sp *Spinner) UpdatePrefixColor(color ...string) {
-	sp.prefixColor = strings.Join(color, "")
+func (sp *Spinner) UpdatePrefixColor(color ...Style) {
+	sp.mu.Lock()
+	sp.prefixColor = composeStyles(color)
+	sp.mu.Unlock()
 }
 
-// UpdateDoneMesgColor changes the done message shown next to the spinner.
-func (sp *Spinner) UpdateDoneMesgColor(mesg ...string) {
-	sp.doneMessageColor = strings.Join(mesg, "")
+// UpdateDoneMesgColor changes the color of the done message.
+func (sp *Spinner) UpdateDoneMesgColor(color ...Style) {
+	sp.doneMessageColor = composeStyles(color)
+}
+
+// UpdateFailMesgColor changes the color of the fail message.
+func (sp *Spinner) UpdateFailMesgColor(color ...Style) {
+	sp.failMessageColor = composeStyles(color)
 }
 
 // UpdateSpinnerColor changes the color of the spinner.
-func (sp *Spinner) UpdateSpinnerColor(color ...string) {
-	sp.spinnerColor = strings.Join(color, "")
+func (sp *Spinner) UpdateSpinnerColor(color ...Style) {
+	sp.mu.Lock()
+	sp.spinnerColor = composeStyles(color)
+	sp.spinnerColors = nil
+	sp.mu.Unlock()
 }
 
-// UpdateSymbols updates the spinner symbols.
+// UpdateSymbols updates the spinner symbols, use WithAnimation or
+// WithSymbolSet to also adopt the animation interval.
 func (sp *Spinner) UpdateSymbols(opt Option) {
 	sp.mu.Lock()
 	opt(sp)
 	sp.mu.Unlock()
 }
 
-// currentMessage safely constructs and returns the current message.
-func (sp *Spinner) currentMessage() string {
+// currentMessage safely constructs and returns the current message, fitted
+// in the given width (-1 for unlimited).
+func (sp *Spinner) currentMessage(width int) string {
 	if sp.message == "" {
 		return ""
 	}
 	sp.messageUpdate.RLock()
 	defer sp.messageUpdate.RUnlock()
 
-	return sp.messageColor + sp.message + ColorReset
+	mesg := sp.applyEffect(sp.fitMessage(sp.message, width))
+
+	return sp.messageColor.String() + mesg + ColorReset
 }
 
 // currentFrame returns the spinner frame for the given iteration.
 func (sp *Spinner) currentFrame(i int) string {
-	if len(sp.symbols) == 0 {
+	if len(sp.frames) == 0 {
 		return ""
 	}
-	sp.frameIdx = i % len(sp.symbols)
-	sp.frame = sp.symbols[sp.frameIdx]
+	sp.frameIdx = sp.frameIndex(i, len(sp.frames))
+	f := sp.frames[sp.frameIdx]
+	sp.frame = f.Symbol
+
+	color, colors := sp.spinnerColor, sp.spinnerColors
+	switch {
+	case !f.Style.IsZero():
+		color = f.Style
+	case len(colors) > 0:
+		color = color.Add(colors[i%len(colors)])
+	}
 
-	return sp.spinnerColor + sp.frame + ColorReset
+	return color.String() + sp.frame + ColorReset
 }
 
-// parsePrefix updates the spinner prefix.
-func (sp *Spinner) parsePrefix(frame, mesg string) {
-	sp.prefixMu.RLock()
-	prefix := sp.prefixColor + sp.prefixMesg + ColorReset
-	sp.prefixMu.RUnlock()
-	del := sp.delimiterColor + sp.delimiter + ColorReset
+// frameDuration returns how long the current frame is displayed.
+func (sp *Spinner) frameDuration() time.Duration {
+	if sp.frameIdx < len(sp.frames) && sp.frames[sp.frameIdx].Duration > 0 {
+		return sp.frames[sp.frameIdx].Duration
+	}
+	if !sp.frequencyPinned && sp.frameIdx < len(sp.animDurations) && sp.animDurations[sp.frameIdx] > 0 {
+		return sp.animDurations[sp.frameIdx]
+	}
 
-	sp.display(fmt.Sprintf("%s%s%s %s", prefix, del, frame, mesg))
+	return sp.frequency
 }
 
 // display writes the given string to the output.
 func (sp *Spinner) display(s string) {
-	if isRedirected(sp.Writer) {
+	if !isInteractive(sp) {
 		_, _ = fmt.Fprint(sp.Writer, removeANSI(s))
 		return
 	}
-	_, _ = fmt.Fprintf(sp.Writer, "%s%s", clearChars, s)
+	_, _ = fmt.Fprintf(sp.Writer, "%s%s", clearChars, degrade(s, sp.colorProfile))
 }
 
 // stopSpinner handles the common logic for stopping the spinner.
@@ -363,71 +429,72 @@ func (sp *Spinner) stopSpinner() {
 	sp.mu.Lock()
 	defer sp.mu.Unlock()
 
+	sp.stop()
+}
+
+// stop stops the animation and restores the cursor, the caller must hold
+// the spinner lock.
+func (sp *Spinner) stop() {
 	if !sp.isActive {
 		return
 	}
 
 	sp.isActive = false
+	if sp.stopInterrupt != nil {
+		sp.stopInterrupt()
+		sp.stopInterrupt = nil
+	}
 
 	if !isInteractive(sp) {
 		return
 	}
 
-	defer showCursor(sp.Writer)
+	defer sp.showCursor()
 	sp.doneChan <- struct{}{}
 }
 
 // displayMessage formats and displays a message with optional prefix and color.
-func (sp *Spinner) displayMessage(symbol, color string, mesg ...string) {
+func (sp *Spinner) displayMessage(symbol string, color Style, mesg ...string) {
 	if len(mesg) == 0 {
 		return
 	}
 
-	s := strings.Join(mesg, " ")
-	s = color + s + "\n"
+	s := color.String() + strings.Join(mesg, " ") + ColorReset
 
 	if !isInteractive(sp) {
-		sp.display(s)
-		return
-	}
-
-	if sp.prefixMesg != "" {
-		sp.parsePrefix(symbol, s)
-		fmt.Print(ColorReset)
+		sp.display(s + "\n")
 		return
 	}
 
-	sp.display(symbol + " " + s + ColorReset)
+	sp.display(sp.line(symbol, s) + "\n")
 }
 
 // removeANSI removes ANSI codes from a given string.
 func removeANSI(s string) string {
-	re := regexp.MustCompile(`\x1b\[[0-9;]*m`)
-	return re.ReplaceAllString(s, "")
+	return sgrRe.ReplaceAllString(s, "")
 }
 
 // New returns a new spinner.
 func New(opt ...Option) *Spinner {
 	sp := &Spinner{
-		frequency:  100 * time.Millisecond,
-		delimiter:  nbsp,
-		isActive:   false,
-		message:    "Loading...",
-		mu:         &sync.RWMutex{},
-		prefixMesg: "",
-		doneChan:   make(chan struct{}, 1),
-		doneSymbol: "✓",
-		failSymbol: "✗",
-		symbols:    defaultSymbols,
-		Writer:     os.Stdout,
+		frequency:    100 * time.Millisecond,
+		delimiter:    nbsp,
+		isActive:     false,
+		message:      "Loading...",
+		mu:           &sync.RWMutex{},
+		prefixMesg:   "",
+		doneChan:     make(chan struct{}, 1),
+		doneSymbol:   "✓",
+		failSymbol:   "✗",
+		frames:       framesOf(defaultSymbols),
+		Writer:       os.Stdout,
+		colorProfile: DetectColorProfile(),
+		clock:        realClock{},
 	}
 	for _, fn := range opt {
 		fn(sp)
 	}
-
-	setupInterruptHandler(context.Background(), func() {
-		showCursor(sp.Writer)
-	})
+	applyEnv(sp)
 
 	return sp
 }
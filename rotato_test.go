@@ -2,22 +2,45 @@ package rotato
 
 import (
 	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/haaag/rotato/rotatotest"
 )
 
+// newFakeClock returns a fake clock for deterministic animation.
+func newFakeClock() *rotatotest.FakeClock {
+	return rotatotest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// advanceFrames advances the clock by d n times, waiting for the running
+// spinner to render each frame.
+func advanceFrames(clock *rotatotest.FakeClock, d time.Duration, n int) {
+	for i := 0; i < n; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(d)
+	}
+	clock.BlockUntil(1)
+}
+
 func TestSpinnerOutput(t *testing.T) {
-	var buf bytes.Buffer
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
 	mesg := "Testing"
-	sp := New(WithWriter(&buf), WithMesg(mesg))
+	sp := New(WithWriter(term), WithClock(clock), WithMesg(mesg))
 	sp.frequency = 10 * time.Millisecond
 
 	sp.Start()
-	time.Sleep(50 * time.Millisecond)
+	advanceFrames(clock, 10*time.Millisecond, 5)
 	sp.Done("Done")
 
-	output := buf.String()
+	output := term.Output()
 	if output == "" {
 		t.Error("expected spinner output, got empty string")
 	}
@@ -29,14 +52,15 @@ func TestSpinnerOutput(t *testing.T) {
 // TestSpinnerState verifies that after Stop() the spinner is no longer
 // running.
 func TestSpinnerState(t *testing.T) {
-	var buf bytes.Buffer
+	clock := newFakeClock()
 	sp := New(
-		WithWriter(&buf),
+		WithWriter(rotatotest.NewTerminal(80)),
+		WithClock(clock),
 		WithSpinnerFrequency(10*time.Millisecond),
 		WithSymbols([]string{"-", "\\", "|", "/"}...),
 	)
 	sp.Start()
-	time.Sleep(20 * time.Millisecond)
+	advanceFrames(clock, 10*time.Millisecond, 2)
 	// verify that the spinner state is true.
 	if !sp.isActive {
 		t.Error("expected spinner to be running")
@@ -51,21 +75,22 @@ func TestSpinnerState(t *testing.T) {
 // TestSpinnerMessageUpdate verifies that the spinner's message can be updated
 // while running.
 func TestSpinnerMessageUpdate(t *testing.T) {
-	var buf bytes.Buffer
-
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
 	sp := New(
-		WithWriter(&buf),
+		WithWriter(term),
+		WithClock(clock),
 		WithSpinnerFrequency(10*time.Millisecond),
 		WithMesg("Initial"),
 	)
 	sp.Start()
-	time.Sleep(20 * time.Millisecond)
+	advanceFrames(clock, 10*time.Millisecond, 2)
 	// Update the message.
 	sp.UpdateMesg("Updated")
-	time.Sleep(50 * time.Millisecond)
+	advanceFrames(clock, 10*time.Millisecond, 2)
 	sp.Done("Done")
 
-	out := buf.String()
+	out := term.Output()
 	if !strings.Contains(out, "Updated") {
 		t.Errorf("expected spinner output to contain updated message, got %q", out)
 	}
@@ -90,7 +115,7 @@ func TestRemoveANSI(t *testing.T) {
 	}{
 		{
 			name:  "Simple ANSI codes",
-			input: ColorRed + ColorStyleBold + "Hello" + ColorReset,
+			input: ColorRed.String() + ColorStyleBold.String() + "Hello" + ColorReset,
 			want:  "Hello",
 		},
 		{
@@ -100,17 +125,17 @@ func TestRemoveANSI(t *testing.T) {
 		},
 		{
 			name:  "Multiple ANSI sequences",
-			input: "Text " + ColorRed + "Red" + ColorReset + " and " + ColorGreen + "Green" + ColorReset,
+			input: "Text " + ColorRed.Render("Red") + " and " + ColorGreen.Render("Green"),
 			want:  "Text Red and Green",
 		},
 		{
 			name:  "ANSI only",
-			input: ColorBlue + ColorStyleBold + "Blue Bold Text" + ColorReset,
+			input: ColorBlue.Add(ColorStyleBold).Render("Blue Bold Text"),
 			want:  "Blue Bold Text",
 		},
 		{
 			name:  "Empty ANSI",
-			input: ColorBlue,
+			input: ColorBlue.String(),
 			want:  "",
 		},
 	}
@@ -124,3 +149,605 @@ func TestRemoveANSI(t *testing.T) {
 		})
 	}
 }
+
+func TestFrameStyles(t *testing.T) {
+	sp := New(
+		WithSpinnerColor(ColorBlue),
+		WithFrames(
+			Frame{Symbol: "R", Style: ColorRed},
+			Frame{Symbol: "-"},
+		),
+	)
+
+	if got, want := sp.currentFrame(0), ColorRed.Render("R"); got != want {
+		t.Errorf("expected styled frame %q, got %q", want, got)
+	}
+	if got, want := sp.currentFrame(1), ColorBlue.Render("-"); got != want {
+		t.Errorf("expected spinner color frame %q, got %q", want, got)
+	}
+	if got := strings.Join(sp.Symbols(), ""); got != "R-" {
+		t.Errorf("expected symbols without escapes, got %q", got)
+	}
+}
+
+func TestWithTheme(t *testing.T) {
+	sp := New(WithPrefixColor(ColorRed), WithTheme(Theme{
+		Animation:    Animation{Frames: []string{"a", "b"}},
+		SpinnerColor: ColorBlue,
+		DoneSymbol:   "ok",
+	}))
+
+	if got := strings.Join(sp.Symbols(), ""); got != "ab" {
+		t.Errorf("expected theme symbols, got %q", got)
+	}
+	if sp.spinnerColor != ColorBlue || sp.doneSymbol != "ok" {
+		t.Error("expected theme spinner color and done symbol to be applied")
+	}
+	if sp.prefixColor != ColorRed {
+		t.Error("expected zero theme fields to leave options untouched")
+	}
+	if sp.failSymbol != "✗" {
+		t.Errorf("expected default fail symbol, got %q", sp.failSymbol)
+	}
+	sp = New(WithSpinnerFrequency(time.Second), WithTheme(ThemeOcean))
+	if sp.frequency != time.Second {
+		t.Errorf("expected pinned frequency to be kept, got %v", sp.frequency)
+	}
+
+	for _, name := range []string{"forest", "sunset"} {
+		theme, _ := LookupTheme(name)
+		sp = New(WithTheme(theme))
+		if sp.frequency != theme.Animation.Interval || sp.frequency == 100*time.Millisecond {
+			t.Errorf("%s: expected the animation interval, got %v", name, sp.frequency)
+		}
+	}
+}
+
+// unregisterSymbols removes the given sets from the registry when the test
+// ends.
+func unregisterSymbols(t *testing.T, names ...string) {
+	t.Cleanup(func() {
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		for _, name := range names {
+			delete(registry.sets, name)
+		}
+	})
+}
+
+// TestRegistryComplete verifies that every WithSymbols* set in symbols.go is
+// registered under its lowercase name.
+func TestRegistryComplete(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "symbols.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			continue
+		}
+		name, ok := strings.CutPrefix(fn.Name.Name, "WithSymbols")
+		if !ok || name == "" {
+			continue
+		}
+		if _, ok := SymbolSet(strings.ToLower(name)); !ok {
+			t.Errorf("%s is missing from the registry as %q", fn.Name.Name, strings.ToLower(name))
+		}
+	}
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	frames, ok := SymbolSet("dots2")
+	if !ok || strings.Join(frames, "") != strings.Join(animationOf("dots2", WithSymbolsDots2()).Frames, "") {
+		t.Errorf("expected dots2 symbol set, got %q", frames)
+	}
+
+	unregisterSymbols(t, "test-set", "empty")
+	if err := RegisterSymbols("test-set", "x", "y"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := RegisterSymbols("empty"); err == nil {
+		t.Error("expected error registering a set without frames")
+	}
+
+	found := false
+	for _, name := range SymbolNames() {
+		found = found || name == "test-set"
+	}
+	if !found {
+		t.Error("expected registered set in SymbolNames()")
+	}
+
+	sp := New(WithSymbolSet("test-set"))
+	if got := strings.Join(sp.Symbols(), ""); got != "xy" {
+		t.Errorf("expected registered symbols, got %q", got)
+	}
+}
+
+func TestRegisterSpinnersJSON(t *testing.T) {
+	unregisterSymbols(t, "test-dots", "test-line", "test-empty", "test-moon")
+	names, err := RegisterSpinnersJSON(strings.NewReader(`{
+		"dots": {"interval": 80, "frames": ["⠋", "⠙", "⠹"]},
+		"line": {"interval": 130, "frames": ["-", "\\", "|", "/"]}
+	}`), "test-")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(names, ","); got != "test-dots,test-line" {
+		t.Errorf("expected registered names, got %q", got)
+	}
+
+	sp := New(WithSymbolSet("test-line"))
+	if got := strings.Join(sp.Symbols(), ""); got != `-\|/` {
+		t.Errorf("expected imported frames, got %q", got)
+	}
+	if sp.frequency != 130*time.Millisecond {
+		t.Errorf("expected imported interval as frequency, got %v", sp.frequency)
+	}
+
+	_, err = RegisterSpinnersJSON(strings.NewReader(`{"test-empty": {"interval": 80, "frames": []}}`), "")
+	if err == nil {
+		t.Error("expected error importing a spinner without frames")
+	}
+	if _, ok := SymbolSet("test-empty"); ok {
+		t.Error("expected invalid spinner not to be registered")
+	}
+
+	want, _ := SymbolSet("dots")
+	_, err = RegisterSpinnersJSON(strings.NewReader(`{
+		"dots": {"interval": 80, "frames": ["a", "b"]},
+		"test-moon": {"interval": 80, "frames": ["c"]}
+	}`), "")
+	if err == nil || !strings.Contains(err.Error(), `"dots"`) {
+		t.Errorf("expected error importing a built-in name, got %v", err)
+	}
+	if got, _ := SymbolSet("dots"); strings.Join(got, "") != strings.Join(want, "") {
+		t.Errorf("expected built-in dots to be kept, got %q", got)
+	}
+	if _, ok := SymbolSet("test-moon"); ok {
+		t.Error("expected no spinner registered from an import with clashes")
+	}
+}
+
+func TestAnimationInterval(t *testing.T) {
+	sp := New(WithSymbolsClock())
+	if a, _ := LookupAnimation("clock"); sp.frequency != a.Interval {
+		t.Errorf("expected clock interval %v, got %v", a.Interval, sp.frequency)
+	}
+
+	sp = New(WithSpinnerFrequency(time.Second), WithSymbolsDots())
+	if sp.frequency != time.Second {
+		t.Errorf("expected explicit frequency to be kept, got %v", sp.frequency)
+	}
+
+	sp = New()
+	sp.UpdateSymbols(WithAnimation(Animation{Frames: []string{"a"}, Interval: 42 * time.Millisecond}))
+	if sp.frequency != 42*time.Millisecond {
+		t.Errorf("expected updated animation interval, got %v", sp.frequency)
+	}
+
+	a := Animation{Frames: []string{"a", "🕐", ColorRed.Render("abc")}}
+	if got := a.Width(); got != 3 {
+		t.Errorf("expected animation width 3, got %d", got)
+	}
+}
+
+func TestFrameDuration(t *testing.T) {
+	sp := New(
+		WithSpinnerFrequency(10*time.Millisecond),
+		WithFrames(Frame{Symbol: "a"}, Frame{Symbol: "b", Duration: time.Second}),
+	)
+
+	sp.currentFrame(0)
+	if got := sp.frameDuration(); got != 10*time.Millisecond {
+		t.Errorf("expected frequency for frame without duration, got %v", got)
+	}
+	sp.currentFrame(1)
+	if got := sp.frameDuration(); got != time.Second {
+		t.Errorf("expected keyframe duration, got %v", got)
+	}
+
+	a, _ := LookupAnimation("barblock")
+	if got := a.Durations[len(a.Durations)-1]; got != holdDuration {
+		t.Errorf("expected barblock to hold its last frame, got %v", got)
+	}
+}
+
+func TestPlayback(t *testing.T) {
+	tests := []struct {
+		name     string
+		playback Playback
+		want     string
+	}{
+		{name: "Forward", playback: PlaybackForward, want: "abcdabcd"},
+		{name: "Reverse", playback: PlaybackReverse, want: "dcbadcba"},
+		{name: "PingPong", playback: PlaybackPingPong, want: "abcdcbab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := New(WithSymbols("a", "b", "c", "d"), WithPlayback(tt.playback))
+			var got strings.Builder
+			for i := 0; i < len(tt.want); i++ {
+				sp.currentFrame(i)
+				got.WriteString(sp.frame)
+			}
+			if got.String() != tt.want {
+				t.Errorf("expected frames %q, got %q", tt.want, got.String())
+			}
+		})
+	}
+
+	sp := New(WithSymbols("a", "b"), WithPlayback(PlaybackRandom))
+	prev := ""
+	for i := 0; i < 10; i++ {
+		sp.currentFrame(i)
+		if sp.frame == prev {
+			t.Fatalf("expected random playback not to repeat frame %q", prev)
+		}
+		prev = sp.frame
+	}
+}
+
+func TestFitMessage(t *testing.T) {
+	sp := New(WithWidth(20), WithSymbols("-"))
+	mesg := "/very/long/path/to/some/file.zip"
+	width := sp.messageWidth(sp.currentFrame(0))
+	if width != 17 {
+		t.Fatalf("expected message width 17, got %d", width)
+	}
+	if got := sp.fitMessage(mesg, width); got != "/very/long/path/…" {
+		t.Errorf("expected truncated message, got %q", got)
+	}
+
+	if got := scrollText("abcdef", 4, 5); got != "ef   " {
+		t.Errorf("expected scrolled window, got %q", got)
+	}
+	if got := scrollText("abcdef", 7, 5); got != "  abc" {
+		t.Errorf("expected scrolled window to wrap around, got %q", got)
+	}
+	if got := sp.fitMessage("short", width); got != "short" {
+		t.Errorf("expected short message untouched, got %q", got)
+	}
+
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
+	sp = New(
+		WithWriter(term),
+		WithClock(clock),
+		WithWidth(20),
+		WithSymbols("-"),
+		WithSpinnerFrequency(100*time.Millisecond),
+		WithMarquee(100*time.Millisecond),
+		WithMesg("Starting"),
+	)
+	sp.Start()
+	defer sp.Done()
+	advanceFrames(clock, 100*time.Millisecond, 50)
+	sp.UpdateMesg(mesg)
+	advanceFrames(clock, 100*time.Millisecond, 1)
+	if got := term.Screen(); got != "- very/long/path/to" {
+		t.Errorf("expected updated message to scroll from its start, got %q", got)
+	}
+}
+
+func TestMesgEffects(t *testing.T) {
+	if got := typewriter("Loading", 3); got != "Loa" {
+		t.Errorf("expected partially typed message, got %q", got)
+	}
+	if got := typewriter("Loading", 30); got != "Loading" {
+		t.Errorf("expected fully typed message, got %q", got)
+	}
+
+	got := shimmer("Loading", shimmerBand+2, ColorGray)
+	want := "Lo" + ColorReset + shimmerStyle.String() + "adi" + ColorReset + ColorGray.String() + "ng"
+	if got != want {
+		t.Errorf("expected shimmer highlight %q, got %q", want, got)
+	}
+	if got := shimmer("Loading", 0, ColorGray); got != "Loading" {
+		t.Errorf("expected shimmer off-screen, got %q", got)
+	}
+
+	var buf bytes.Buffer
+	sp := New(WithWriter(&buf), WithMesgEffect(EffectTypewriter))
+	if got := sp.applyEffect("Loading"); got != "Loading" {
+		t.Errorf("expected effects disabled when redirected, got %q", got)
+	}
+
+	clock := newFakeClock()
+	term := rotatotest.NewTerminal(80)
+	sp = New(WithWriter(term), WithClock(clock), WithMesgEffect(EffectTypewriter))
+	sp.messageAt = clock.Now()
+	if got := sp.applyEffect("Loading"); got != "" {
+		t.Errorf("expected typewriter effect on an interactive writer, got %q", got)
+	}
+
+	sp = New(WithWriter(term), WithClock(clock), WithMesgEffect(EffectTypewriter), WithReducedMotion(true))
+	sp.messageAt = clock.Now()
+	if got := sp.applyEffect("Loading"); got != "Loading" {
+		t.Errorf("expected effects disabled with reduced motion, got %q", got)
+	}
+
+	t.Setenv(envReduced, "1")
+	sp = New(WithWriter(term), WithClock(clock), WithMesgEffect(EffectTypewriter))
+	sp.messageAt = clock.Now()
+	if got := sp.applyEffect("Loading"); got != "Loading" {
+		t.Errorf("expected effects disabled with %s, got %q", envReduced, got)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	sp := New(WithPrefix("Repo"), WithDelimiter(":"))
+	if got := removeANSI(sp.layout("-", "Syncing")); got != "Repo:- Syncing" {
+		t.Errorf("expected default layout with prefix, got %q", got)
+	}
+
+	sp = New(WithDelimiter(":"))
+	if got := removeANSI(sp.layout("-", "Syncing")); got != "- Syncing" {
+		t.Errorf("expected default layout without prefix, got %q", got)
+	}
+
+	clock := newFakeClock()
+	sp = New(
+		WithClock(clock),
+		WithPrefix("Repo"),
+		WithTemplate("{message} {spinner} [{prefix}] {elapsed}"),
+	)
+	sp.startedAt = clock.Now()
+	clock.Advance(90 * time.Second)
+	if got := removeANSI(sp.layout("-", "Syncing")); got != "Syncing - [Repo] 01:30" {
+		t.Errorf("expected custom layout, got %q", got)
+	}
+
+	term := rotatotest.NewTerminal(80)
+	sp = New(WithWriter(term), WithTemplate("{spinner} {message} {elapsed}"))
+	sp.Done("x")
+	if got := term.Screen(); got != "✓ x 0.0s" {
+		t.Errorf("expected zero elapsed time for a spinner never started, got %q", got)
+	}
+	if got := removeANSI(sp.layout("-", "x")); got != "- x 0.0s" {
+		t.Errorf("expected zero elapsed time before start, got %q", got)
+	}
+}
+
+func TestSuffixAndStatus(t *testing.T) {
+	sp := New(WithWidth(30), WithSuffix("(3 files)"), WithStatus("[3/10]"))
+	if got := removeANSI(sp.line("-", "Copying")); got != "- Copying (3 files)"+strings.Repeat(" ", 4)+"[3/10]" {
+		t.Errorf("expected status pinned to the right edge, got %q", got)
+	}
+
+	sp.UpdateStatus("[4/10] 00:42")
+	sp.UpdateSuffix("")
+	got := removeANSI(sp.line("-", "Copying"))
+	if !strings.HasSuffix(got, " [4/10] 00:42") || textWidth(got) != 29 {
+		t.Errorf("expected updated status at column 29, got %q", got)
+	}
+}
+
+func TestPowerline(t *testing.T) {
+	p := Powerline{
+		Separator: ">",
+		Prefix:    BgColor256(1),
+		Spinner:   BgColor256(2),
+		Message:   BgColor256(3),
+	}
+	sp := New(WithPrefix("Repo"), WithPowerline(p), WithSpinnerColor(ColorRed))
+
+	got := sp.layout(ColorRed.Render("-"), "Syncing")
+	if plain := removeANSI(got); plain != " Repo > - > Syncing >" {
+		t.Errorf("expected powerline segments, got %q", plain)
+	}
+
+	sep := Style{Fg: BgColor256(1).Bg, Bg: BgColor256(2).Bg}
+	if !strings.Contains(got, sep.Render(">")) {
+		t.Errorf("expected separator colored with adjacent backgrounds, got %q", got)
+	}
+	spinnerSeg := p.Spinner.String() + " " + ColorRed.String() + "-" + ColorReset + p.Spinner.String() + " "
+	if !strings.Contains(got, spinnerSeg) {
+		t.Errorf("expected spinner color drawn over the spinner segment, got %q", got)
+	}
+
+	opts := []Option{
+		WithClock(newFakeClock()),
+		WithColorProfile(ProfileANSI256),
+		WithPowerline(p),
+		WithFrames(Frame{Symbol: "*", Style: ColorBlue}),
+		WithDoneColorMesg(ColorGreen),
+		WithFailColorMesg(ColorRed),
+	}
+	sp = New(opts...)
+	if got := sp.layout(sp.currentFrame(0), "Syncing"); !strings.Contains(got, ColorBlue.String()+"*") {
+		t.Errorf("expected frame style in the spinner segment, got %q", got)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		fn    func(*Spinner, ...string)
+		color Style
+	}{
+		{"Done", (*Spinner).Done, ColorGreen},
+		{"Fail", (*Spinner).Fail, ColorRed},
+	} {
+		term := rotatotest.NewTerminal(80)
+		sp := New(append(opts, WithWriter(term))...)
+		sp.Start()
+		tt.fn(sp, "Synced")
+		want := tt.color.String() + "Synced" + ColorReset + p.Message.String()
+		if got := term.Output(); !strings.Contains(got, want) {
+			t.Errorf("%s: expected message color in the message segment, got %q", tt.name, got)
+		}
+		if got := term.Screen(); !strings.Contains(got, "Synced") {
+			t.Errorf("%s: expected final line on screen, got %q", tt.name, got)
+		}
+	}
+
+	sp = New(WithWidth(30), WithPowerline(DefaultPowerline), WithPrefix("Repo"))
+	mesg := sp.fitMessage(strings.Repeat("long message ", 5), sp.messageWidth("-"))
+	if got := textWidth(sp.line("-", mesg)); got >= 30 {
+		t.Errorf("expected powerline to fit in 30 columns, got %d", got)
+	}
+}
+
+func TestSpinnerTerminal(t *testing.T) {
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
+	sp := New(
+		WithWriter(term),
+		WithClock(clock),
+		WithSpinnerFrequency(5*time.Millisecond),
+		WithPrefix("Repo"),
+		WithDelimiter(" "),
+		WithMesg("Syncing"),
+	)
+	sp.Start()
+	advanceFrames(clock, 5*time.Millisecond, 3)
+	sp.Done("Synced")
+
+	if got, want := term.Screen(), "Repo ✓ Synced"; got != want {
+		t.Errorf("expected screen %q, got %q", want, got)
+	}
+	if !strings.Contains(term.Output(), "Syncing") {
+		t.Error("expected the animation to be rendered before done")
+	}
+}
+
+func TestWithInteractive(t *testing.T) {
+	var buf bytes.Buffer
+	clock := newFakeClock()
+	sp := New(WithWriter(&buf), WithClock(clock), WithInteractive(true), WithMesg("Working"))
+	sp.Start()
+	advanceFrames(clock, sp.frequency, 1)
+	sp.Done("Done")
+	if !strings.Contains(buf.String(), clearChars) {
+		t.Errorf("expected animated output on a forced interactive writer, got %q", buf.String())
+	}
+
+	term := rotatotest.NewTerminal(80)
+	sp = New(WithWriter(term), WithInteractive(false), WithMesg("Working"))
+	sp.Start()
+	sp.Done("Done")
+	if got := term.Output(); strings.Contains(got, "\x1b") {
+		t.Errorf("expected plain output on a forced non-interactive writer, got %q", got)
+	}
+}
+
+func TestSetNonInteractive(t *testing.T) {
+	SetNonInteractive()
+	t.Cleanup(func() { SetInteractiveDefault(true) })
+
+	term := rotatotest.NewTerminal(80)
+	if isInteractive(New(WithWriter(term))) {
+		t.Error("expected the global default to make spinners non-interactive")
+	}
+	if !isInteractive(New(WithWriter(term), WithInteractive(true))) {
+		t.Error("expected WithInteractive to override the global default")
+	}
+
+	SetInteractiveDefault(true)
+	if !isInteractive(New(WithWriter(term))) {
+		t.Error("expected the reset default to detect the terminal writer")
+	}
+
+	clock := newFakeClock()
+	sp := New(WithWriter(term), WithClock(clock))
+	sp.Start()
+	advanceFrames(clock, sp.frequency, 1)
+	SetNonInteractive()
+	sp.Done("ok")
+	if got := term.Screen(); got != "✓ ok" {
+		t.Errorf("expected a running spinner to keep its output mode, got %q", got)
+	}
+	if !term.CursorVisible() {
+		t.Error("expected the cursor to be restored")
+	}
+}
+
+func TestCursorUsesWriter(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	t.Cleanup(func() { os.Stdout = stdout })
+
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
+	sp := New(WithWriter(term), WithClock(clock), WithMesg("Working"))
+	sp.Start()
+	advanceFrames(clock, sp.frequency, 1)
+	if term.CursorVisible() {
+		t.Error("expected cursor to be hidden while spinning")
+	}
+	if got := term.Screen(); !strings.Contains(got, "Working") {
+		t.Errorf("expected spinner on screen, got %q", got)
+	}
+
+	sp.Done()
+	if !term.CursorVisible() {
+		t.Error("expected cursor to be restored after done")
+	}
+	if got := term.Screen(); got != "" {
+		t.Errorf("expected done without message to clear the line, got %q", got)
+	}
+
+	_ = w.Close()
+	leaked, _ := io.ReadAll(r)
+	if len(leaked) > 0 {
+		t.Errorf("expected nothing written to stdout, got %q", leaked)
+	}
+}
+
+func TestInterrupt(t *testing.T) {
+	term := rotatotest.NewTerminal(80)
+	clock := newFakeClock()
+	var got os.Signal
+	sp := New(
+		WithWriter(term),
+		WithClock(clock),
+		WithInterruptHandler(func(sig os.Signal) { got = sig }),
+	)
+	sp.Start()
+	advanceFrames(clock, sp.frequency, 1)
+	if sp.stopInterrupt == nil {
+		t.Fatal("expected the interrupt handler to be installed at start")
+	}
+
+	sp.interrupt(os.Interrupt)
+	if got != os.Interrupt {
+		t.Errorf("expected the handler to receive %v, got %v", os.Interrupt, got)
+	}
+	if !sp.Interrupted() || sp.isActive {
+		t.Error("expected the spinner to be stopped and marked as interrupted")
+	}
+	if !term.CursorVisible() {
+		t.Error("expected the cursor to be restored")
+	}
+
+	sp.Start()
+	sp.Done("Done")
+	if sp.Interrupted() || sp.stopInterrupt != nil {
+		t.Error("expected done to stop the interrupt handler")
+	}
+}
+
+func TestInterruptPlain(t *testing.T) {
+	for _, mode := range []string{"plain", "json"} {
+		t.Setenv(envMode, mode)
+
+		var buf bytes.Buffer
+		var got os.Signal
+		sp := New(WithWriter(&buf), WithInterruptHandler(func(sig os.Signal) { got = sig }))
+		sp.Start()
+		if sp.stopInterrupt == nil {
+			t.Fatalf("%s: expected the interrupt handler to be installed at start", mode)
+		}
+
+		sp.interrupt(os.Interrupt)
+		if got != os.Interrupt || !sp.Interrupted() {
+			t.Errorf("%s: expected the spinner to be marked as interrupted", mode)
+		}
+	}
+}
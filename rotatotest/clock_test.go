@@ -0,0 +1,33 @@
+package rotatotest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	ch := clock.After(100 * time.Millisecond)
+	clock.BlockUntil(1)
+	clock.Advance(50 * time.Millisecond)
+	select {
+	case <-ch:
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	select {
+	case got := <-ch:
+		if want := start.Add(100 * time.Millisecond); !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	default:
+		t.Fatal("timer did not fire at its deadline")
+	}
+	if got := clock.Now().Sub(start); got != 100*time.Millisecond {
+		t.Errorf("expected clock to advance 100ms, got %v", got)
+	}
+}
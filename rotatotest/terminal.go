@@ -0,0 +1,274 @@
+// Package rotatotest provides utilities for testing rotato spinners.
+package rotatotest
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Terminal is an in-memory terminal emulator. It interprets carriage
+// returns, line feeds, line and screen clearing, cursor movement and cursor
+// visibility sequences, so tests can assert on the visible screen. Colors
+// and other SGR sequences are ignored, every rune uses a single cell.
+//
+// Terminal reports itself as a terminal, so spinners writing to it use the
+// interactive output.
+type Terminal struct {
+	mu            sync.Mutex
+	width         int      // Columns before wrapping, zero never wraps
+	lines         [][]rune // Screen cells
+	row, col      int      // Cursor position
+	cursorVisible bool     // Cursor visibility
+	pending       []byte   // Incomplete UTF-8 sequence or escape sequence
+	raw           strings.Builder
+}
+
+// NewTerminal returns a new terminal that wraps lines at the given width,
+// or never wraps if width is zero.
+func NewTerminal(width int) *Terminal {
+	return &Terminal{width: width, cursorVisible: true}
+}
+
+// IsTerminal reports that the writer is a terminal.
+func (t *Terminal) IsTerminal() bool {
+	return true
+}
+
+// Write interprets p and updates the screen.
+func (t *Terminal) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.raw.Write(p)
+	buf := append(t.pending, p...)
+	t.pending = nil
+
+	for len(buf) > 0 {
+		switch buf[0] {
+		case '\x1b':
+			n, ok := t.escape(buf)
+			if !ok {
+				t.pending = append([]byte(nil), buf...)
+				return len(p), nil
+			}
+			buf = buf[n:]
+			continue
+		case '\r':
+			t.col = 0
+		case '\n':
+			t.row++
+			t.col = 0
+		case '\b':
+			t.col = max(t.col-1, 0)
+		case '\t':
+			t.col += 8 - t.col%8
+		default:
+			if !utf8.FullRune(buf) {
+				t.pending = append([]byte(nil), buf...)
+				return len(p), nil
+			}
+			r, size := utf8.DecodeRune(buf)
+			t.put(r)
+			buf = buf[size:]
+			continue
+		}
+		buf = buf[1:]
+	}
+
+	return len(p), nil
+}
+
+// escape interprets the escape sequence at the start of buf and returns its
+// length, or false if the sequence is incomplete.
+func (t *Terminal) escape(buf []byte) (int, bool) {
+	if len(buf) < 2 {
+		return 0, false
+	}
+	if buf[1] != '[' {
+		// unsupported escape, skip ESC and the next byte.
+		return 2, true
+	}
+
+	end := 2
+	for end < len(buf) && (buf[end] < 0x40 || buf[end] > 0x7e) {
+		end++
+	}
+	if end == len(buf) {
+		return 0, false
+	}
+
+	t.csi(string(buf[2:end]), buf[end])
+
+	return end + 1, true
+}
+
+// csi interprets a control sequence with the given parameters and final
+// byte.
+func (t *Terminal) csi(params string, final byte) {
+	if strings.HasPrefix(params, "?") {
+		if params == "?25" {
+			t.cursorVisible = final == 'h'
+		}
+		return
+	}
+
+	args := strings.Split(params, ";")
+	arg := func(i, def int) int {
+		if i >= len(args) || args[i] == "" {
+			return def
+		}
+		n, err := strconv.Atoi(args[i])
+		if err != nil {
+			return def
+		}
+		return n
+	}
+
+	switch final {
+	case 'A':
+		t.row = max(t.row-arg(0, 1), 0)
+	case 'B':
+		t.row += arg(0, 1)
+	case 'C':
+		t.col += arg(0, 1)
+	case 'D':
+		t.col = max(t.col-arg(0, 1), 0)
+	case 'E':
+		t.row += arg(0, 1)
+		t.col = 0
+	case 'F':
+		t.row = max(t.row-arg(0, 1), 0)
+		t.col = 0
+	case 'G':
+		t.col = max(arg(0, 1)-1, 0)
+	case 'H', 'f':
+		t.row = max(arg(0, 1)-1, 0)
+		t.col = max(arg(1, 1)-1, 0)
+	case 'K':
+		t.eraseLine(arg(0, 0))
+	case 'J':
+		t.eraseScreen(arg(0, 0))
+	}
+}
+
+// put writes r at the cursor position and advances the cursor.
+func (t *Terminal) put(r rune) {
+	if t.width > 0 && t.col >= t.width {
+		t.row++
+		t.col = 0
+	}
+
+	line := t.line(t.row)
+	for len(*line) <= t.col {
+		*line = append(*line, ' ')
+	}
+	(*line)[t.col] = r
+	t.col++
+}
+
+// line returns the cells of the given row, growing the screen if needed.
+func (t *Terminal) line(row int) *[]rune {
+	for len(t.lines) <= row {
+		t.lines = append(t.lines, nil)
+	}
+
+	return &t.lines[row]
+}
+
+// eraseLine erases from the cursor to the end of the line (0), from the
+// start of the line to the cursor (1) or the whole line (2).
+func (t *Terminal) eraseLine(mode int) {
+	line := t.line(t.row)
+	switch mode {
+	case 0:
+		if t.col < len(*line) {
+			*line = (*line)[:t.col]
+		}
+	case 1:
+		for i := 0; i <= t.col && i < len(*line); i++ {
+			(*line)[i] = ' '
+		}
+	case 2:
+		*line = nil
+	}
+}
+
+// eraseScreen erases from the cursor to the end of the screen (0), from the
+// start of the screen to the cursor (1) or the whole screen (2).
+func (t *Terminal) eraseScreen(mode int) {
+	switch mode {
+	case 0:
+		t.eraseLine(0)
+		if t.row+1 < len(t.lines) {
+			t.lines = t.lines[:t.row+1]
+		}
+	case 1:
+		for i := 0; i < t.row && i < len(t.lines); i++ {
+			t.lines[i] = nil
+		}
+		t.eraseLine(1)
+	case 2:
+		t.lines = nil
+	}
+}
+
+// Screen returns the visible screen, lines joined with newlines, without
+// trailing spaces and trailing empty lines.
+func (t *Terminal) Screen() string {
+	return strings.Join(t.Lines(), "\n")
+}
+
+// Lines returns the visible screen lines, without trailing spaces and
+// trailing empty lines.
+func (t *Terminal) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := make([]string, len(t.lines))
+	for i, l := range t.lines {
+		lines[i] = strings.TrimRight(string(l), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// Line returns the given screen line, without trailing spaces.
+func (t *Terminal) Line(row int) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if row < 0 || row >= len(t.lines) {
+		return ""
+	}
+
+	return strings.TrimRight(string(t.lines[row]), " ")
+}
+
+// Cursor returns the cursor position, zero based.
+func (t *Terminal) Cursor() (row, col int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.row, t.col
+}
+
+// CursorVisible reports whether the cursor is visible.
+func (t *Terminal) CursorVisible() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.cursorVisible
+}
+
+// Output returns the raw bytes written to the terminal.
+func (t *Terminal) Output() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.raw.String()
+}
@@ -0,0 +1,64 @@
+package rotatotest
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTerminal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "Plain text", input: "hello", want: "hello"},
+		{name: "Carriage return overwrites", input: "hello\rHe", want: "Hello"},
+		{name: "Clear line", input: "hello\r\x1b[Kbye", want: "bye"},
+		{name: "Line feed", input: "one\ntwo", want: "one\ntwo"},
+		{name: "Colors ignored", input: "\x1b[1;38;5;214mhot\x1b[0m", want: "hot"},
+		{name: "Cursor movement", input: "one\ntwo\x1b[1A\rONE", want: "ONE\ntwo"},
+		{name: "Cursor column", input: "abcdef\x1b[3GX", want: "abXdef"},
+		{name: "Clear screen", input: "one\ntwo\x1b[2J\x1b[Hnew", want: "new"},
+		{name: "Unicode", input: "⠋ Loading\r⠙", want: "⠙ Loading"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			term := NewTerminal(0)
+			_, _ = fmt.Fprint(term, tt.input)
+			if got := term.Screen(); got != tt.want {
+				t.Errorf("Screen() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerminalSplitWrites(t *testing.T) {
+	term := NewTerminal(0)
+	input := []byte("⠋ \x1b[92mok\x1b[0m")
+	for _, b := range input {
+		_, _ = term.Write([]byte{b})
+	}
+	if got := term.Screen(); got != "⠋ ok" {
+		t.Errorf("Screen() = %q; want %q", got, "⠋ ok")
+	}
+}
+
+func TestTerminalCursorAndWrap(t *testing.T) {
+	term := NewTerminal(4)
+	_, _ = fmt.Fprint(term, "\x1b[?25labcdef")
+	if term.CursorVisible() {
+		t.Error("expected cursor to be hidden")
+	}
+	if got := term.Screen(); got != "abcd\nef" {
+		t.Errorf("expected wrapped lines, got %q", got)
+	}
+
+	_, _ = fmt.Fprint(term, "\x1b[?25h")
+	if !term.CursorVisible() {
+		t.Error("expected cursor to be visible")
+	}
+	if row, col := term.Cursor(); row != 1 || col != 2 {
+		t.Errorf("expected cursor at 1,2, got %d,%d", row, col)
+	}
+}
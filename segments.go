@@ -0,0 +1,102 @@
+package rotato
+
+import "strings"
+
+// WithSuffix returns an option function that sets the spinner suffix,
+// rendered after the message.
+func WithSuffix(s string) Option {
+	return func(sp *Spinner) {
+		sp.suffixMesg = s
+	}
+}
+
+// WithSuffixColor returns an option function that sets the spinner suffix
+// color.
+func WithSuffixColor(color ...Style) Option {
+	return func(sp *Spinner) {
+		sp.suffixColor = composeStyles(color)
+	}
+}
+
+// WithStatus returns an option function that sets the spinner status,
+// pinned to the right edge of the terminal.
+func WithStatus(s string) Option {
+	return func(sp *Spinner) {
+		sp.statusMesg = s
+	}
+}
+
+// WithStatusColor returns an option function that sets the spinner status
+// color.
+func WithStatusColor(color ...Style) Option {
+	return func(sp *Spinner) {
+		sp.statusColor = composeStyles(color)
+	}
+}
+
+// UpdateSuffix changes the suffix shown after the message.
+func (sp *Spinner) UpdateSuffix(mesg string) {
+	sp.segmentMu.Lock()
+	sp.suffixMesg = mesg
+	sp.segmentMu.Unlock()
+}
+
+// UpdateSuffixColor changes the color of the suffix.
+func (sp *Spinner) UpdateSuffixColor(color ...Style) {
+	sp.segmentMu.Lock()
+	sp.suffixColor = composeStyles(color)
+	sp.segmentMu.Unlock()
+}
+
+// UpdateStatus changes the status pinned to the right edge.
+func (sp *Spinner) UpdateStatus(mesg string) {
+	sp.segmentMu.Lock()
+	sp.statusMesg = mesg
+	sp.segmentMu.Unlock()
+}
+
+// UpdateStatusColor changes the color of the status.
+func (sp *Spinner) UpdateStatusColor(color ...Style) {
+	sp.segmentMu.Lock()
+	sp.statusColor = composeStyles(color)
+	sp.segmentMu.Unlock()
+}
+
+// suffix returns the colored suffix preceded by a space, or an empty string
+// if there is no suffix.
+func (sp *Spinner) suffix() string {
+	sp.segmentMu.RLock()
+	defer sp.segmentMu.RUnlock()
+	if sp.suffixMesg == "" {
+		return ""
+	}
+
+	return " " + sp.suffixColor.String() + sp.suffixMesg + ColorReset
+}
+
+// status returns the colored status, or an empty string if there is no
+// status.
+func (sp *Spinner) status() string {
+	sp.segmentMu.RLock()
+	defer sp.segmentMu.RUnlock()
+	if sp.statusMesg == "" {
+		return ""
+	}
+
+	return sp.statusColor.String() + sp.statusMesg + ColorReset
+}
+
+// line composes the full spinner line, with the status pinned to the right
+// edge when the line width is known.
+func (sp *Spinner) line(frame, mesg string) string {
+	left := sp.layout(frame, mesg)
+	status := sp.status()
+	if status == "" {
+		return left
+	}
+
+	// leave the last column empty so the line never wraps.
+	pad := sp.lineWidth() - 1 - textWidth(left) - textWidth(status)
+
+	return left + strings.Repeat(" ", max(pad, 1)) + status
+}
@@ -0,0 +1,234 @@
+package rotato
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// colorKind represents the kind of color stored in a Color.
+type colorKind uint8
+
+const (
+	colorNone colorKind = iota
+	colorANSI
+	colorANSI256
+	colorRGB
+)
+
+// Color represents a terminal color. The zero value means no color.
+type Color struct {
+	kind    colorKind
+	r, g, b uint8 // palette index is stored in r for ANSI and ANSI256
+}
+
+// IsZero reports whether c is the zero value (no color).
+func (c Color) IsZero() bool {
+	return c.kind == colorNone
+}
+
+// RGB returns the red, green and blue components of the color. Palette
+// colors use the xterm defaults.
+func (c Color) RGB() (r, g, b uint8) {
+	switch c.kind {
+	case colorANSI, colorANSI256:
+		rgb := ansi256ToRGB(int(c.r))
+		return rgb[0], rgb[1], rgb[2]
+	case colorRGB:
+		return c.r, c.g, c.b
+	}
+
+	return 0, 0, 0
+}
+
+// params returns the SGR parameters for the color.
+func (c Color) params(bg bool) []string {
+	base := "38"
+	if bg {
+		base = "48"
+	}
+
+	switch c.kind {
+	case colorANSI:
+		return []string{strconv.Itoa(ansi16Param(int(c.r), bg))}
+	case colorANSI256:
+		return []string{base, "5", strconv.Itoa(int(c.r))}
+	case colorRGB:
+		return []string{
+			base, "2", strconv.Itoa(int(c.r)), strconv.Itoa(int(c.g)), strconv.Itoa(int(c.b)),
+		}
+	}
+
+	return nil
+}
+
+// Style represents the foreground, background and text attributes used to
+// render a piece of text.
+type Style struct {
+	Fg            Color // Foreground color
+	Bg            Color // Background color
+	Bold          bool
+	Dim           bool
+	Italic        bool
+	Underline     bool
+	Blink         bool
+	Inverse       bool
+	Strikethrough bool
+}
+
+// Add returns a new style composed of s and the given styles. Colors from
+// later styles replace earlier ones, attributes are combined.
+func (s Style) Add(styles ...Style) Style {
+	for _, o := range styles {
+		if !o.Fg.IsZero() {
+			s.Fg = o.Fg
+		}
+		if !o.Bg.IsZero() {
+			s.Bg = o.Bg
+		}
+		s.Bold = s.Bold || o.Bold
+		s.Dim = s.Dim || o.Dim
+		s.Italic = s.Italic || o.Italic
+		s.Underline = s.Underline || o.Underline
+		s.Blink = s.Blink || o.Blink
+		s.Inverse = s.Inverse || o.Inverse
+		s.Strikethrough = s.Strikethrough || o.Strikethrough
+	}
+
+	return s
+}
+
+// IsZero reports whether s has no colors and no attributes.
+func (s Style) IsZero() bool {
+	return s == Style{}
+}
+
+// String returns the escape sequence for the style.
+func (s Style) String() string {
+	attrs := []struct {
+		on    bool
+		param string
+	}{
+		{s.Bold, "1"},
+		{s.Dim, "2"},
+		{s.Italic, "3"},
+		{s.Underline, "4"},
+		{s.Blink, "5"},
+		{s.Inverse, "7"},
+		{s.Strikethrough, "9"},
+	}
+
+	params := make([]string, 0, len(attrs)+10)
+	for _, a := range attrs {
+		if a.on {
+			params = append(params, a.param)
+		}
+	}
+	params = append(params, s.Fg.params(false)...)
+	params = append(params, s.Bg.params(true)...)
+	if len(params) == 0 {
+		return ""
+	}
+
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// Render returns text wrapped in the style escape sequence, followed by a
+// reset.
+func (s Style) Render(text string) string {
+	if s.IsZero() || text == "" {
+		return text
+	}
+
+	return s.String() + text + ColorReset
+}
+
+// composeStyles composes the given styles into one.
+func composeStyles(styles []Style) Style {
+	return Style{}.Add(styles...)
+}
+
+// ansiColor returns a style with a basic foreground color (0-15).
+func ansiColor(n uint8) Style {
+	return Style{Fg: Color{kind: colorANSI, r: n}}
+}
+
+// styleNames maps the names accepted by ParseStyle to their styles.
+var styleNames = map[string]Style{
+	"black":         ColorBlack,
+	"blue":          ColorBlue,
+	"cyan":          ColorCyan,
+	"gray":          ColorGray,
+	"green":         ColorGreen,
+	"magenta":       ColorMagenta,
+	"orange":        ColorOrange,
+	"purple":        ColorPurple,
+	"red":           ColorRed,
+	"white":         ColorWhite,
+	"yellow":        ColorYellow,
+	"brightblack":   ColorBrightBlack,
+	"brightblue":    ColorBrightBlue,
+	"brightcyan":    ColorBrightCyan,
+	"brightgray":    ColorBrightGray,
+	"brightgreen":   ColorBrightGreen,
+	"brightmagenta": ColorBrightMagenta,
+	"brightorange":  ColorBrightOrange,
+	"brightpurple":  ColorBrightPurple,
+	"brightred":     ColorBrightRed,
+	"brightwhite":   ColorBrightWhite,
+	"brightyellow":  ColorBrightYellow,
+	"bold":          ColorStyleBold,
+	"dim":           ColorStyleDim,
+	"inverse":       ColorStyleInverse,
+	"italic":        ColorStyleItalic,
+	"strikethrough": ColorStyleStrikethrough,
+	"underline":     ColorStyleUnderline,
+	"blink":         ColorStyleBlink,
+}
+
+// ParseStyle parses a style from a list of color names, hex colors, 256
+// palette indexes and attributes separated by spaces or commas, like
+// "bold #ff8800" or "brightgreen italic bg:black". Colors prefixed with
+// "bg:" are used as background.
+func ParseStyle(s string) (Style, error) {
+	var style Style
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t'
+	})
+	for _, field := range fields {
+		name, bg := strings.CutPrefix(strings.ToLower(field), "bg:")
+		st, err := parseStyleToken(name)
+		if err != nil {
+			return Style{}, err
+		}
+		if bg {
+			if st.Fg.IsZero() {
+				return Style{}, fmt.Errorf("%q is not a color", field)
+			}
+			st = Style{Bg: st.Fg}
+		}
+		style = style.Add(st)
+	}
+
+	return style, nil
+}
+
+// parseStyleToken parses a single color name, hex color, palette index or
+// attribute.
+func parseStyleToken(s string) (Style, error) {
+	if st, ok := styleNames[s]; ok {
+		return st, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		rgb, ok := parseHex(s)
+		if !ok {
+			return Style{}, fmt.Errorf("invalid hex color %q", s)
+		}
+		return RGB(rgb[0], rgb[1], rgb[2]), nil
+	}
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return Color256(uint8(n)), nil
+	}
+
+	return Style{}, fmt.Errorf("unknown color or style %q", s)
+}
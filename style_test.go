@@ -0,0 +1,72 @@
+package rotato
+
+import (
+	"testing"
+
+	"github.com/haaag/rotato/rotatotest"
+)
+
+func TestStyleString(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  string
+	}{
+		{name: "Zero", style: Style{}, want: ""},
+		{name: "Basic", style: ColorRed, want: "\x1b[31m"},
+		{name: "Bright", style: ColorBrightGreen, want: "\x1b[92m"},
+		{name: "Composed", style: ColorBrightOrange.Add(ColorStyleBold, BgHex("#000")), want: "\x1b[1;38;5;214;48;2;0;0;0m"},
+		{name: "Later color wins", style: ColorRed.Add(ColorBlue), want: "\x1b[34m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.String(); got != tt.want {
+				t.Errorf("String() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGradient(t *testing.T) {
+	colors := gradient([]Style{RGB(0, 0, 0), RGB(255, 255, 255)}, 4)
+	if len(colors) != 8 {
+		t.Fatalf("expected 8 gradient colors, got %d", len(colors))
+	}
+	if got := colors[0]; got != RGB(0, 0, 0) {
+		t.Errorf("expected gradient to start with the first color, got %v", got)
+	}
+	if got := colors[2]; got != RGB(128, 128, 128) {
+		t.Errorf("expected gradient midpoint to be gray, got %v", got)
+	}
+	if got := colors[4]; got != RGB(255, 255, 255) {
+		t.Errorf("expected gradient to reach the second color, got %v", got)
+	}
+
+	if got := len(rainbow(rainbowSteps)); got != rainbowSteps {
+		t.Errorf("expected %d rainbow colors, got %d", rainbowSteps, got)
+	}
+}
+
+func TestUpdateColorWhileRunning(t *testing.T) {
+	clock := newFakeClock()
+	sp := New(
+		WithWriter(rotatotest.NewTerminal(80)),
+		WithClock(clock),
+		WithSpinnerRainbow(),
+	)
+	sp.Start()
+	defer sp.Done()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			sp.UpdateSpinnerColor(ColorRed)
+			sp.UpdateMesgColor(ColorGray)
+			sp.UpdatePrefixColor(ColorBlue)
+		}
+	}()
+	advanceFrames(clock, sp.frequency, 20)
+	<-done
+}
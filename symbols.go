@@ -1,71 +1,94 @@
 package rotato
 
+import "time"
+
 var defaultSymbols = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 
+// Frame represents a single frame of the spinner animation.
+type Frame struct {
+	Symbol   string        // Frame symbol
+	Style    Style         // Frame style, replaces the spinner color if not zero
+	Duration time.Duration // Frame duration, zero uses the spinner frequency
+}
+
 // WithSymbols returns an option function that sets the spinner unicode
 // animation.
 func WithSymbols(symbols ...string) Option {
 	return func(sp *Spinner) {
-		sp.symbols = symbols
+		sp.frames = framesOf(symbols)
+		sp.animDurations = nil
+	}
+}
+
+// WithFrames returns an option function that sets the spinner animation
+// with a style per frame.
+func WithFrames(frames ...Frame) Option {
+	return func(sp *Spinner) {
+		sp.frames = frames
+		sp.animDurations = nil
 	}
 }
 
+// framesOf returns unstyled frames for the given symbols.
+func framesOf(symbols []string) []Frame {
+	frames := make([]Frame, len(symbols))
+	for i, s := range symbols {
+		frames[i] = Frame{Symbol: s}
+	}
+
+	return frames
+}
+
 // WithSymbolsBlock returns an option function that sets the spinner unicode
 // animation with blocks.
 //
 //	"░", "▒", "▒", "░", "▓".
 func WithSymbolsBlock() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"░", "▒", "▒", "░", "▓"}
-	}
+	return withInterval(120*time.Millisecond, "░", "▒", "▒", "░", "▓")
 }
 
 // WithSymbolsBarBlock returns an option function that sets the spinner
-// unicode animation with bars.
+// unicode animation with bars, holding the last frame.
 //
 //	"█▒▒▒▒▒▒▒▒▒", "███▒▒▒▒▒▒▒", "█████▒▒▒▒▒", "███████▒▒▒", "██████████".
 func WithSymbolsBarBlock() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"█▒▒▒▒▒▒▒▒▒", "███▒▒▒▒▒▒▒", "█████▒▒▒▒▒", "███████▒▒▒", "██████████"}
-	}
+	return withHold(120*time.Millisecond, "█▒▒▒▒▒▒▒▒▒", "███▒▒▒▒▒▒▒", "█████▒▒▒▒▒", "███████▒▒▒", "██████████")
 }
 
 // WithSymbolsBarBlock2 returns an option function that sets the spinner
-// unicode animation with bars.
+// unicode animation with bars, holding the last frame.
 //
 //	"[|       ]", "[||      ]", "[|||     ]", "[||||    ]", "[|||||   ]", "[||||||  ]", "[||||||| ]", "[||||||||]".
 func WithSymbolsBarBlock2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"[       ]",
-			"[|      ]",
-			"[||     ]",
-			"[|||    ]",
-			"[||||   ]",
-			"[|||||  ]",
-			"[|||||| ]",
-			"[|||||||]",
-		}
-	}
+	return withHold(
+		100*time.Millisecond,
+		"[       ]",
+		"[|      ]",
+		"[||     ]",
+		"[|||    ]",
+		"[||||   ]",
+		"[|||||  ]",
+		"[|||||| ]",
+		"[|||||||]",
+	)
 }
 
 // WithSymbolsBarBlock3 returns an option function that sets the spinner
-// unicode animation with bars.
+// unicode animation with bars, holding the last frame.
 //
 //	"[=       ]", "[==      ]", "[===     ]", "[====    ]", "[=====   ]", "[======  ]", "[======= ]", "[========]".
 func WithSymbolsBarBlock3() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"[       ]",
-			"[=      ]",
-			"[==     ]",
-			"[===    ]",
-			"[====   ]",
-			"[=====  ]",
-			"[====== ]",
-			"[=======]",
-		}
-	}
+	return withHold(
+		100*time.Millisecond,
+		"[       ]",
+		"[=      ]",
+		"[==     ]",
+		"[===    ]",
+		"[====   ]",
+		"[=====  ]",
+		"[====== ]",
+		"[=======]",
+	)
 }
 
 // WithSymbolsBarBlock4 returns an option function that sets the spinner
@@ -74,25 +97,24 @@ func WithSymbolsBarBlock3() Option {
 //	"|", "||", "|||", "||||", "|||||", "||||||", "|||||||", "||||||||",
 //	"|||||||", "||||||", "|||||", "||||", "|||", "||", "|".
 func WithSymbolsBarBlock4() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"|",
-			"||",
-			"|||",
-			"||||",
-			"|||||",
-			"||||||",
-			"|||||||",
-			"||||||||",
-			"|||||||",
-			"||||||",
-			"|||||",
-			"||||",
-			"|||",
-			"||",
-			"|",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"|",
+		"||",
+		"|||",
+		"||||",
+		"|||||",
+		"||||||",
+		"|||||||",
+		"||||||||",
+		"|||||||",
+		"||||||",
+		"|||||",
+		"||||",
+		"|||",
+		"||",
+		"|",
+	)
 }
 
 // WithSymbolsBarBlock5 returns an option function that sets the spinner
@@ -102,25 +124,24 @@ func WithSymbolsBarBlock4() Option {
 //	"[-----*--]", "[------*-]", "[-------*]", "[------*-]", "[-----*--]".
 //	"[----*---]", "[---*----]", "[--*-----]", "[-*------]", "[*-------]".
 func WithSymbolsBarBlock5() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"[*-------]",
-			"[-*------]",
-			"[--*-----]",
-			"[---*----]",
-			"[----*---]",
-			"[-----*--]",
-			"[------*-]",
-			"[-------*]",
-			"[------*-]",
-			"[-----*--]",
-			"[----*---]",
-			"[---*----]",
-			"[--*-----]",
-			"[-*------]",
-			"[*-------]",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"[*-------]",
+		"[-*------]",
+		"[--*-----]",
+		"[---*----]",
+		"[----*---]",
+		"[-----*--]",
+		"[------*-]",
+		"[-------*]",
+		"[------*-]",
+		"[-----*--]",
+		"[----*---]",
+		"[---*----]",
+		"[--*-----]",
+		"[-*------]",
+		"[*-------]",
+	)
 }
 
 // WithSymbolsBarBlock6 returns an option function that sets the spinner
@@ -129,21 +150,20 @@ func WithSymbolsBarBlock5() Option {
 //	"·-----", "-·----", "--·---", "---·--", "----·-", "-----·",
 //	"----·-", "---·--", "--·---", "-·----", "·-----",
 func WithSymbolsBarBlock6() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"·-----",
-			"-·----",
-			"--·---",
-			"---·--",
-			"----·-",
-			"-----·",
-			"----·-",
-			"---·--",
-			"--·---",
-			"-·----",
-			"·-----",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"·-----",
+		"-·----",
+		"--·---",
+		"---·--",
+		"----·-",
+		"-----·",
+		"----·-",
+		"---·--",
+		"--·---",
+		"-·----",
+		"·-----",
+	)
 }
 
 // WithSymbolsBlockPretty returns an option function that sets the spinner
@@ -151,10 +171,8 @@ func WithSymbolsBarBlock6() Option {
 //
 //	"", "", "", "", "", "", "".
 func WithSymbolsBlockPretty() Option {
-	return func(sp *Spinner) {
-		//  
-		sp.symbols = []string{"", "", "", "", "", "", ""}
-	}
+	//  
+	return withInterval(100*time.Millisecond, "", "", "", "", "", "", "")
 }
 
 // WithSymbolsDots returns an option function that sets the spinner unicode
@@ -162,9 +180,7 @@ func WithSymbolsBlockPretty() Option {
 //
 //	"⣾", "⣽", "⣻", "⢿", "⡿", "⣟", "⣯", "⣷".
 func WithSymbolsDots() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"⣾", "⣽", "⣻", "⢿", "⡿", "⣟", "⣯", "⣷"}
-	}
+	return withInterval(80*time.Millisecond, "⣾", "⣽", "⣻", "⢿", "⡿", "⣟", "⣯", "⣷")
 }
 
 // WithSymbolsDots2 returns an option function that sets the spinner unicode
@@ -172,16 +188,15 @@ func WithSymbolsDots() Option {
 //
 //	"  . . . .", ".   . . .", ". .   . .", ". . .   .", ". . . .  ", ". . . . .".
 func WithSymbolsDots2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"  . . . .",
-			".   . . .",
-			". .   . .",
-			". . .   .",
-			". . . .  ",
-			". . . . .",
-		}
-	}
+	return withInterval(
+		150*time.Millisecond,
+		"  . . . .",
+		".   . . .",
+		". .   . .",
+		". . .   .",
+		". . . .  ",
+		". . . . .",
+	)
 }
 
 // WithSymbolsDots3 returns an option function that sets the spinner unicode
@@ -189,9 +204,7 @@ func WithSymbolsDots2() Option {
 //
 //	"⠄", "⠆", "⠇", "⠋", "⠙", "⠸", "⠰", "⠠", "⠰", "⠸", "⠙", "⠋", "⠇", "⠆".
 func WithSymbolsDots3() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"⠄", "⠆", "⠇", "⠋", "⠙", "⠸", "⠰", "⠠", "⠰", "⠸", "⠙", "⠋", "⠇", "⠆"}
-	}
+	return withInterval(80*time.Millisecond, "⠄", "⠆", "⠇", "⠋", "⠙", "⠸", "⠰", "⠠", "⠰", "⠸", "⠙", "⠋", "⠇", "⠆")
 }
 
 // WithSymbolsDots4 returns an option function that sets the spinner unicode
@@ -199,9 +212,7 @@ func WithSymbolsDots3() Option {
 //
 //	"⠁", "⠂", "⠄", "⡀", "⢀", "⠠", "⠐", "⠈".
 func WithSymbolsDots4() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"⠁", "⠂", "⠄", "⡀", "⢀", "⠠", "⠐", "⠈"}
-	}
+	return withInterval(100*time.Millisecond, "⠁", "⠂", "⠄", "⡀", "⢀", "⠠", "⠐", "⠈")
 }
 
 // WithSymbolsDots5 returns an option function that sets the spinner unicode
@@ -209,39 +220,38 @@ func WithSymbolsDots4() Option {
 //
 //	"⠁", "⠁", "⠉", "⠙", "⠚", "⠒", "⠂", "⠂", "⠒".
 func WithSymbolsDots5() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"⠁",
-			"⠁",
-			"⠉",
-			"⠙",
-			"⠚",
-			"⠒",
-			"⠂",
-			"⠂",
-			"⠒",
-			"⠲",
-			"⠴",
-			"⠤",
-			"⠄",
-			"⠄",
-			"⠤",
-			"⠠",
-			"⠠",
-			"⠤",
-			"⠦",
-			"⠖",
-			"⠒",
-			"⠐",
-			"⠐",
-			"⠒",
-			"⠓",
-			"⠋",
-			"⠉",
-			"⠈",
-			"⠈",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"⠁",
+		"⠁",
+		"⠉",
+		"⠙",
+		"⠚",
+		"⠒",
+		"⠂",
+		"⠂",
+		"⠒",
+		"⠲",
+		"⠴",
+		"⠤",
+		"⠄",
+		"⠄",
+		"⠤",
+		"⠠",
+		"⠠",
+		"⠤",
+		"⠦",
+		"⠖",
+		"⠒",
+		"⠐",
+		"⠐",
+		"⠒",
+		"⠓",
+		"⠋",
+		"⠉",
+		"⠈",
+		"⠈",
+	)
 }
 
 // WithSymbolsLines returns an option function that sets the spinner unicode
@@ -249,9 +259,7 @@ func WithSymbolsDots5() Option {
 //
 //	"⠂", "-", "–", "—", "–", "-".
 func WithSymbolsLines() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"⠂", "-", "–", "—", "–", "-"}
-	}
+	return withInterval(130*time.Millisecond, "⠂", "-", "–", "—", "–", "-")
 }
 
 // WithSymbolsWave returns an option function that sets the spinner unicode
@@ -259,9 +267,7 @@ func WithSymbolsLines() Option {
 //
 //	"⢄", "⢂", "⢁", "⡀", "⠈", "⠘", "⠸".
 func WithSymbolsWave() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"⢄", "⢂", "⢁", "⡀", "⠈", "⠘", "⠸"}
-	}
+	return withInterval(100*time.Millisecond, "⢄", "⢂", "⢁", "⡀", "⠈", "⠘", "⠸")
 }
 
 // WithSymbolsGrow returns an option function that sets the spinner unicode
@@ -269,9 +275,7 @@ func WithSymbolsWave() Option {
 //
 //	"▉", "▊", "▋", "▌", "▍", "▎", "▏".
 func WithSymbolsGrow() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"▉", "▊", "▋", "▌", "▍", "▎", "▏"}
-	}
+	return withInterval(120*time.Millisecond, "▉", "▊", "▋", "▌", "▍", "▎", "▏")
 }
 
 // WithSymbolsGrowVert returns an option function that sets the spinner unicode
@@ -279,9 +283,7 @@ func WithSymbolsGrow() Option {
 //
 //	"▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃".
 func WithSymbolsGrowVert() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃"}
-	}
+	return withInterval(120*time.Millisecond, "▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃")
 }
 
 // WithSymbolsMoon returns an option function that sets the spinner unicode
@@ -289,9 +291,7 @@ func WithSymbolsGrowVert() Option {
 //
 //	"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘".
 func WithSymbolsMoon() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘"}
-	}
+	return withInterval(120*time.Millisecond, "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘")
 }
 
 // WithSymbolsPipe returns an option function that sets the spinner unicode
@@ -299,9 +299,7 @@ func WithSymbolsMoon() Option {
 //
 //	"|", "/", "-", "\\".
 func WithSymbolsPipe() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"|", "/", "-", "\\"}
-	}
+	return withInterval(100*time.Millisecond, "|", "/", "-", "\\")
 }
 
 // WithSymbolsPipe2 returns an option function that sets the spinner unicode
@@ -309,9 +307,7 @@ func WithSymbolsPipe() Option {
 //
 //	"┤", "┘", "┴", "└", "├", "┌", "┬", "┐".
 func WithSymbolsPipe2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"┤", "┘", "┴", "└", "├", "┌", "┬", "┐"}
-	}
+	return withInterval(100*time.Millisecond, "┤", "┘", "┴", "└", "├", "┌", "┬", "┐")
 }
 
 // WithSymbolsSquare returns an option function that sets the spinner unicode
@@ -319,9 +315,7 @@ func WithSymbolsPipe2() Option {
 //
 //	"▖", "▘", "▝", "▗".
 func WithSymbolsSquare() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"▖", "▘", "▝", "▗"}
-	}
+	return withInterval(180*time.Millisecond, "▖", "▘", "▝", "▗")
 }
 
 // WithSymbolsSquare2 returns an option function that sets the spinner unicode
@@ -329,9 +323,7 @@ func WithSymbolsSquare() Option {
 //
 //	"", "", "", "", "".
 func WithSymbolsSquare2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", "", ""}
-	}
+	return withInterval(150*time.Millisecond, "", "", "", "", "")
 }
 
 // WithSymbolsClock returns an option function that sets the spinner unicode
@@ -339,9 +331,7 @@ func WithSymbolsSquare2() Option {
 //
 //	"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚".
 func WithSymbolsClock() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"}
-	}
+	return withInterval(200*time.Millisecond, "🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚")
 }
 
 // WithSymbolsDiamond returns an option function that sets the spinner unicode
@@ -349,9 +339,7 @@ func WithSymbolsClock() Option {
 //
 //	"◇", "◈", "⬟", "⬞".
 func WithSymbolsDiamond() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"◇", "◈", "⬟", "⬞"}
-	}
+	return withInterval(150*time.Millisecond, "◇", "◈", "⬟", "⬞")
 }
 
 // WithSymbolsDiamond2 returns an option function that sets the spinner unicode
@@ -359,9 +347,7 @@ func WithSymbolsDiamond() Option {
 //
 //	"", "", "", "".
 func WithSymbolsDiamond2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", ""}
-	}
+	return withInterval(150*time.Millisecond, "", "", "", "")
 }
 
 // WithSymbolsPlusCross returns an option function that sets the spinner unicode
@@ -369,9 +355,7 @@ func WithSymbolsDiamond2() Option {
 //
 //	"+", "x".
 func WithSymbolsPlusCross() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"+", "x"}
-	}
+	return withInterval(200*time.Millisecond, "+", "x")
 }
 
 // WithSymbolsArrows returns an option function that sets the spinner unicode
@@ -379,9 +363,7 @@ func WithSymbolsPlusCross() Option {
 //
 //	"<", "<<", "<<<", "-", ">", ">>", ">>>".
 func WithSymbolsArrows() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"<", "<<", "<<<", "-", ">", ">>", ">>>"}
-	}
+	return withInterval(120*time.Millisecond, "<", "<<", "<<<", "-", ">", ">>", ">>>")
 }
 
 // WithSymbolsArrows2 returns an option function that sets the spinner unicode
@@ -389,9 +371,7 @@ func WithSymbolsArrows() Option {
 //
 //	">   ", ">>  ", ">>> ", ">>>>".
 func WithSymbolsArrows2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{">   ", ">>  ", ">>> ", ">>>>"}
-	}
+	return withInterval(120*time.Millisecond, ">   ", ">>  ", ">>> ", ">>>>")
 }
 
 // WithSymbolsArrows3 returns an option function that sets the spinner unicode
@@ -399,9 +379,7 @@ func WithSymbolsArrows2() Option {
 //
 //	"▹▹▹▹▹", "▸▹▹▹▹", "▹▸▹▹▹", "▹▹▸▹▹", "▹▹▹▸▹", "▹▹▹▹▸".
 func WithSymbolsArrows3() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"▹▹▹▹▹", "▸▹▹▹▹", "▹▸▹▹▹", "▹▹▸▹▹", "▹▹▹▸▹", "▹▹▹▹▸"}
-	}
+	return withInterval(120*time.Millisecond, "▹▹▹▹▹", "▸▹▹▹▹", "▹▸▹▹▹", "▹▹▸▹▹", "▹▹▹▸▹", "▹▹▹▹▸")
 }
 
 // WithSymbolsArrows4 returns an option function that sets the spinner unicode
@@ -409,9 +387,7 @@ func WithSymbolsArrows3() Option {
 //
 //	"←", "↖", "↑", "↗", "→", "↘", "↓", "↙".
 func WithSymbolsArrows4() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"←", "↖", "↑", "↗", "→", "↘", "↓", "↙"}
-	}
+	return withInterval(100*time.Millisecond, "←", "↖", "↑", "↗", "→", "↘", "↓", "↙")
 }
 
 // WithSymbolsCircles returns an option function that sets the spinner unicode
@@ -419,9 +395,7 @@ func WithSymbolsArrows4() Option {
 //
 //	"o", "O", "@", "*".
 func WithSymbolsCircles() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"o", "O", "@", "*"}
-	}
+	return withInterval(130*time.Millisecond, "o", "O", "@", "*")
 }
 
 // WithSymbolsCircles2 returns an option function that sets the spinner unicode
@@ -429,9 +403,7 @@ func WithSymbolsCircles() Option {
 //
 //	".", "o", "O", "°", "O", "o", ".".
 func WithSymbolsCircles2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{".", "o", "O", "°", "O", "o", "."}
-	}
+	return withInterval(120*time.Millisecond, ".", "o", "O", "°", "O", "o", ".")
 }
 
 // WithSymbolsCircles3 returns an option function that sets the spinner unicode
@@ -439,9 +411,7 @@ func WithSymbolsCircles2() Option {
 //
 //	"●", "●", "●", "●".
 func WithSymbolsCircles3() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"●", "●", "●", "●"}
-	}
+	return withInterval(180*time.Millisecond, "●", "●", "●", "●")
 }
 
 // WithSymbolsCircles4 returns an option function that sets the spinner unicode
@@ -449,9 +419,7 @@ func WithSymbolsCircles3() Option {
 //
 //	"", "", "", "".
 func WithSymbolsCircles4() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", ""}
-	}
+	return withInterval(100*time.Millisecond, "", "", "", "")
 }
 
 // WithSymbolsCircles5 returns an option function that sets the spinner unicode
@@ -459,9 +427,7 @@ func WithSymbolsCircles4() Option {
 //
 //	"", "", "", "".
 func WithSymbolsCircles5() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", "", ""}
-	}
+	return withInterval(100*time.Millisecond, "", "", "", "", "")
 }
 
 // WithSymbolsCircles6 returns an option function that sets the spinner unicode
@@ -469,9 +435,7 @@ func WithSymbolsCircles5() Option {
 //
 //	"", "", "", "", "".
 func WithSymbolsCircles6() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", "", "", ""}
-	}
+	return withInterval(100*time.Millisecond, "", "", "", "", "", "")
 }
 
 // WithSymbolsCircles7 returns an option function that sets the spinner unicode
@@ -479,9 +443,7 @@ func WithSymbolsCircles6() Option {
 //
 //	"", "", "", "", "", "".
 func WithSymbolsCircles7() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"", "", "", "", "", ""}
-	}
+	return withInterval(100*time.Millisecond, "", "", "", "", "", "")
 }
 
 // WithSymbolsBounce returns an option function that sets the spinner unicode
@@ -489,25 +451,24 @@ func WithSymbolsCircles7() Option {
 //
 //	"[    ]", "[=   ]", "[==  ]", "[=== ]", "[ ===]", "[  ==]", "[   =]".
 func WithSymbolsBounce() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"[    ]",
-			"[=   ]",
-			"[==  ]",
-			"[=== ]",
-			"[ ===]",
-			"[  ==]",
-			"[   =]",
-			"[    ]",
-			"[   =]",
-			"[  ==]",
-			"[ ===]",
-			"[====]",
-			"[=== ]",
-			"[==  ]",
-			"[=   ]",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"[    ]",
+		"[=   ]",
+		"[==  ]",
+		"[=== ]",
+		"[ ===]",
+		"[  ==]",
+		"[   =]",
+		"[    ]",
+		"[   =]",
+		"[  ==]",
+		"[ ===]",
+		"[====]",
+		"[=== ]",
+		"[==  ]",
+		"[=   ]",
+	)
 }
 
 // WithSymbolsBounceBall returns an option function that sets the spinner unicode
@@ -515,20 +476,19 @@ func WithSymbolsBounce() Option {
 //
 //	"( ●    )", "(  ●   )", "(   ●  )", "(    ● )", "(     ●)".
 func WithSymbolsBounceBall() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"( ●    )",
-			"(  ●   )",
-			"(   ●  )",
-			"(    ● )",
-			"(     ●)",
-			"(    ● )",
-			"(   ●  )",
-			"(  ●   )",
-			"( ●    )",
-			"(●     )",
-		}
-	}
+	return withInterval(
+		80*time.Millisecond,
+		"( ●    )",
+		"(  ●   )",
+		"(   ●  )",
+		"(    ● )",
+		"(     ●)",
+		"(    ● )",
+		"(   ●  )",
+		"(  ●   )",
+		"( ●    )",
+		"(●     )",
+	)
 }
 
 // WithSymbolsToggle returns an option function that sets the spinner unicode
@@ -536,9 +496,7 @@ func WithSymbolsBounceBall() Option {
 //
 //	"■", "□", "▪", "▫".
 func WithSymbolsToggle() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"■", "□", "▪", "▫"}
-	}
+	return withInterval(250*time.Millisecond, "■", "□", "▪", "▫")
 }
 
 // WithSymbolsToggle2 returns an option function that sets the spinner unicode
@@ -546,9 +504,7 @@ func WithSymbolsToggle() Option {
 //
 //	"=", "*", "-".
 func WithSymbolsToggle2() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"=", "*", "-"}
-	}
+	return withInterval(250*time.Millisecond, "=", "*", "-")
 }
 
 // WithSymbolsToggle3 returns an option function that sets the spinner unicode
@@ -556,29 +512,26 @@ func WithSymbolsToggle2() Option {
 //
 //	"◉", "◎".
 func WithSymbolsToggle3() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{"◉", "◎"}
-	}
+	return withInterval(400*time.Millisecond, "◉", "◎")
 }
 
 // WithSymbolsLoading returns an option function that sets the spinner unicode
-// animation with loading symbols.
+// animation with loading symbols, holding the last frame.
 //
 //	"loading....".
 func WithSymbolsLoading() Option {
-	return func(sp *Spinner) {
-		sp.symbols = []string{
-			"l      ",
-			"lo     ",
-			"loa    ",
-			"load   ",
-			"loadi  ",
-			"loadin ",
-			"loading",
-			"loading.",
-			"loading..",
-			"loading...",
-			"loading....",
-		}
-	}
+	return withHold(
+		120*time.Millisecond,
+		"l      ",
+		"lo     ",
+		"loa    ",
+		"load   ",
+		"loadi  ",
+		"loadin ",
+		"loading",
+		"loading.",
+		"loading..",
+		"loading...",
+		"loading....",
+	)
 }
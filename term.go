@@ -6,70 +6,191 @@ import (
 	"io"
 	"os"
 	"os/signal"
+	"strconv"
+	"sync/atomic"
 	"syscall"
+	"unsafe"
 )
 
 // clearChars represents a sequence of characters used to clear the current
 // line in the terminal.
 const clearChars = "\r\033[K\r"
 
-// nonInteractive indicates whether the terminal is non-interactive.
-var nonInteractive = false
+// nonInteractive indicates whether spinners without an explicit output mode
+// are non-interactive, regardless of their writer.
+var nonInteractive atomic.Bool
 
-// SetNonInteractive sets the terminal to non-interactive mode.
+// SetInteractiveDefault sets whether spinners without an explicit output
+// mode detect interactivity from their writer (true, the default) or are
+// always non-interactive (false), see WithInteractive. Spinners keep the
+// output mode resolved when they started. It is safe for concurrent use.
+func SetInteractiveDefault(interactive bool) {
+	nonInteractive.Store(!interactive)
+}
+
+// SetNonInteractive sets non-interactive output as the default for spinners
+// without an explicit output mode, see SetInteractiveDefault.
 func SetNonInteractive() {
-	nonInteractive = true
+	SetInteractiveDefault(false)
 }
 
-// setupInterruptHandler handles interruptions.
-func setupInterruptHandler(ctx context.Context, onInterrupt func()) {
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(
-		sigChan,
+// setupInterruptHandler calls onInterrupt with the first interrupt signal
+// received until the context is canceled. Signals ignored by the process,
+// like SIGINT in background jobs, are left alone.
+func setupInterruptHandler(ctx context.Context, onInterrupt func(os.Signal)) {
+	var sigs []os.Signal
+	for _, sig := range []os.Signal{
 		os.Interrupt,    // Ctrl+C (SIGINT)
 		syscall.SIGTERM, // Process termination
-	)
+	} {
+		if !signal.Ignored(sig) {
+			sigs = append(sigs, sig)
+		}
+	}
+	if len(sigs) == 0 {
+		return
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, sigs...)
 	go func() {
 		select {
-		case <-sigChan:
-			if onInterrupt != nil {
-				onInterrupt()
-			}
-			// Unregister the signal channel before exiting.
+		case sig := <-sigChan:
+			// Unregister the signal channel, restoring the default behavior.
 			signal.Stop(sigChan)
-			os.Exit(1)
+			onInterrupt(sig)
 		case <-ctx.Done():
 			// Unregister the signal channel when context is canceled.
 			signal.Stop(sigChan)
-			return
+			// A signal received while canceling must not be swallowed.
+			select {
+			case sig := <-sigChan:
+				onInterrupt(sig)
+			default:
+			}
 		}
 	}()
 }
 
-// hideCursor hides the cursor.
-func hideCursor(output io.Writer) {
-	if !isRedirected(output) {
-		fmt.Print("\r\033[?25l\r")
+// WithInterruptHandler returns an option function that sets the function
+// called when a running spinner receives SIGINT or SIGTERM, after the
+// spinner is stopped and the cursor restored. Without a handler the signal
+// is re-raised, so the process ends as if rotato did not handle it.
+//
+// Applications listening for the signal with signal.Notify receive it as
+// well and must set a handler, even a no-op one, to avoid receiving it
+// twice.
+func WithInterruptHandler(fn func(os.Signal)) Option {
+	return func(sp *Spinner) {
+		sp.onInterrupt = fn
+	}
+}
+
+// listenInterrupt listens for interrupt signals until the spinner stops,
+// the caller must hold the spinner lock.
+func (sp *Spinner) listenInterrupt() {
+	sp.interrupted = false
+	ctx, cancel := context.WithCancel(context.Background())
+	sp.stopInterrupt = cancel
+	setupInterruptHandler(ctx, sp.interrupt)
+}
+
+// Interrupted reports whether the spinner was stopped by an interrupt
+// signal.
+func (sp *Spinner) Interrupted() bool {
+	sp.mu.RLock()
+	defer sp.mu.RUnlock()
+
+	return sp.interrupted
+}
+
+// interrupt stops the spinner after an interrupt signal and passes the
+// signal to the interrupt handler, or re-raises it if there is none.
+func (sp *Spinner) interrupt(sig os.Signal) {
+	sp.mu.Lock()
+	if sp.isActive {
+		sp.interrupted = true
+		sp.stop()
+	}
+	handler := sp.onInterrupt
+	sp.mu.Unlock()
+
+	if handler != nil {
+		handler(sig)
+		return
+	}
+	if s, ok := sig.(syscall.Signal); ok {
+		_ = syscall.Kill(os.Getpid(), s)
+	}
+}
+
+// hideCursor hides the cursor on the spinner writer, if interactive.
+func (sp *Spinner) hideCursor() {
+	if isInteractive(sp) {
+		_, _ = fmt.Fprint(sp.Writer, "\r\033[?25l\r")
+	}
+}
+
+// showCursor shows the cursor on the spinner writer, if interactive.
+func (sp *Spinner) showCursor() {
+	if isInteractive(sp) {
+		_, _ = fmt.Fprint(sp.Writer, "\r\033[?25h\r")
 	}
 }
 
-// showCursor shows the cursor.
-func showCursor(output io.Writer) {
-	if !isRedirected(output) {
-		fmt.Print("\r\033[?25h\r")
+// outputMode represents how the spinner writes to its output.
+type outputMode int
+
+const (
+	modeAuto  outputMode = iota // Detect from the writer
+	modeTTY                     // Animated output with escape sequences
+	modePlain                   // Plain lines without escape sequences
+	modeJSON                    // JSON lines, one per spinner event
+)
+
+// WithInteractive returns an option function that forces animated output
+// when interactive is true, or plain output when false, instead of
+// detecting it from the writer. Use it for writers that are terminals but
+// not files, like a pty or an SSH channel.
+func WithInteractive(interactive bool) Option {
+	return func(sp *Spinner) {
+		sp.mode = modePlain
+		if interactive {
+			sp.mode = modeTTY
+		}
 	}
 }
 
-// isInteractive checks if the output is interactive.
+// isInteractive checks if the output is interactive, using the output mode
+// resolved at Start or, if the spinner was never started, the detected one.
 func isInteractive(sp *Spinner) bool {
-	return !isRedirected(sp.Writer)
+	mode := sp.runMode
+	if mode == modeAuto {
+		mode = sp.detectMode()
+	}
+
+	return mode == modeTTY
+}
+
+// detectMode returns the output mode set on the spinner, or detects it from
+// the global default and the writer.
+func (sp *Spinner) detectMode() outputMode {
+	switch {
+	case sp.mode != modeAuto:
+		return sp.mode
+	case nonInteractive.Load(), isRedirected(sp.Writer):
+		return modePlain
+	}
+
+	return modeTTY
 }
 
 // isRedirected checks if the provided output writer is redirected.
 // It returns true if the writer is not a terminal.
 func isRedirected(output io.Writer) bool {
-	if nonInteractive {
-		return true
+	// Writers that are not files can report themselves as terminals.
+	if t, ok := output.(interface{ IsTerminal() bool }); ok {
+		return !t.IsTerminal()
 	}
 	file, ok := output.(*os.File)
 	if !ok {
@@ -93,3 +214,30 @@ func isRedirected(output io.Writer) bool {
 	// If the mode does not indicate a character device, the output is redirected.
 	return (st.Mode & syscall.S_IFMT) != syscall.S_IFCHR
 }
+
+// winsize represents the terminal size returned by the TIOCGWINSZ ioctl.
+type winsize struct {
+	Row, Col, X, Y uint16
+}
+
+// terminalWidth returns the number of columns of the terminal behind the
+// given writer, falling back to `COLUMNS`, or 0 if unknown.
+func terminalWidth(output io.Writer) int {
+	if file, ok := output.(*os.File); ok {
+		var ws winsize
+		_, _, errno := syscall.Syscall(
+			syscall.SYS_IOCTL,
+			file.Fd(),
+			uintptr(syscall.TIOCGWINSZ),
+			uintptr(unsafe.Pointer(&ws)),
+		)
+		if errno == 0 && ws.Col > 0 {
+			return int(ws.Col)
+		}
+	}
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+
+	return 0
+}
@@ -0,0 +1,142 @@
+package rotato
+
+import (
+	"sort"
+	"time"
+)
+
+// Theme bundles the symbols, colors and final state styling of a spinner.
+// Zero fields are left untouched when the theme is applied.
+type Theme struct {
+	Name           string        // Theme name
+	Animation      Animation     // Spinner animation, with its interval and frame durations
+	Frequency      time.Duration // Spinner frequency, unless set with WithSpinnerFrequency
+	SpinnerColor   Style         // Spinner color
+	PrefixColor    Style         // Prefix message color
+	DelimiterColor Style         // Delimiter color
+	MessageColor   Style         // Spinner message color
+	DoneSymbol     string        // Done symbol
+	DoneColor      Style         // Done message color
+	FailSymbol     string        // Fail symbol
+	FailColor      Style         // Fail message color
+}
+
+var (
+	// ThemeClassic is a colorless theme with the pipe animation.
+	ThemeClassic = Theme{
+		Name:       "classic",
+		Animation:  animationOf("pipe", WithSymbolsPipe()),
+		DoneSymbol: "[ok]",
+		FailSymbol: "[failed]",
+	}
+
+	// ThemeOcean is a blue theme with braille dots.
+	ThemeOcean = Theme{
+		Name:           "ocean",
+		Animation:      animationOf("dots", WithSymbolsDots()),
+		Frequency:      80 * time.Millisecond,
+		SpinnerColor:   ColorBrightCyan,
+		PrefixColor:    ColorBrightBlue.Add(ColorStyleBold),
+		DelimiterColor: ColorBlue,
+		MessageColor:   ColorCyan,
+		DoneColor:      ColorBrightCyan.Add(ColorStyleItalic),
+		FailColor:      ColorBrightRed,
+	}
+
+	// ThemeForest is a green theme with growing bars.
+	ThemeForest = Theme{
+		Name:         "forest",
+		Animation:    animationOf("growvert", WithSymbolsGrowVert()),
+		SpinnerColor: ColorBrightGreen,
+		PrefixColor:  ColorGreen.Add(ColorStyleBold),
+		MessageColor: ColorGray,
+		DoneColor:    ColorBrightGreen.Add(ColorStyleItalic),
+		FailColor:    ColorBrightRed.Add(ColorStyleItalic),
+	}
+
+	// ThemeSunset is an orange theme with circles.
+	ThemeSunset = Theme{
+		Name:         "sunset",
+		Animation:    animationOf("circles3", WithSymbolsCircles3()),
+		SpinnerColor: ColorBrightOrange,
+		PrefixColor:  ColorBrightPurple.Add(ColorStyleBold),
+		MessageColor: ColorYellow,
+		DoneColor:    ColorBrightOrange.Add(ColorStyleItalic),
+		FailColor:    ColorRed.Add(ColorStyleBold),
+	}
+
+	// ThemeMinimal is a dimmed theme with small dots.
+	ThemeMinimal = Theme{
+		Name:         "minimal",
+		Animation:    animationOf("dots4", WithSymbolsDots4()),
+		SpinnerColor: ColorGray,
+		PrefixColor:  ColorStyleBold,
+		MessageColor: ColorStyleDim,
+		DoneSymbol:   "•",
+		DoneColor:    ColorStyleDim,
+		FailSymbol:   "×",
+		FailColor:    ColorRed,
+	}
+)
+
+// themes holds the built-in themes by name.
+var themes = map[string]Theme{
+	ThemeClassic.Name: ThemeClassic,
+	ThemeOcean.Name:   ThemeOcean,
+	ThemeForest.Name:  ThemeForest,
+	ThemeSunset.Name:  ThemeSunset,
+	ThemeMinimal.Name: ThemeMinimal,
+}
+
+// WithTheme returns an option function that applies the given theme.
+func WithTheme(t Theme) Option {
+	return func(sp *Spinner) {
+		if len(t.Animation.Frames) > 0 {
+			WithAnimation(t.Animation)(sp)
+		}
+		if t.Frequency > 0 && !sp.frequencyPinned {
+			sp.frequency = t.Frequency
+		}
+		if !t.SpinnerColor.IsZero() {
+			WithSpinnerColor(t.SpinnerColor)(sp)
+		}
+		if !t.PrefixColor.IsZero() {
+			sp.prefixColor = t.PrefixColor
+		}
+		if !t.DelimiterColor.IsZero() {
+			sp.delimiterColor = t.DelimiterColor
+		}
+		if !t.MessageColor.IsZero() {
+			sp.messageColor = t.MessageColor
+		}
+		if t.DoneSymbol != "" {
+			sp.doneSymbol = t.DoneSymbol
+		}
+		if !t.DoneColor.IsZero() {
+			sp.doneMessageColor = t.DoneColor
+		}
+		if t.FailSymbol != "" {
+			sp.failSymbol = t.FailSymbol
+		}
+		if !t.FailColor.IsZero() {
+			sp.failMessageColor = t.FailColor
+		}
+	}
+}
+
+// LookupTheme returns the built-in theme with the given name.
+func LookupTheme(name string) (Theme, bool) {
+	t, ok := themes[name]
+	return t, ok
+}
+
+// ThemeNames returns the sorted names of the built-in themes.
+func ThemeNames() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
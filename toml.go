@@ -0,0 +1,221 @@
+package rotato
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tomlParser parses the subset of TOML used by spinner configs: top-level
+// keys with basic and literal strings, numbers, booleans and arrays.
+type tomlParser struct {
+	src  string
+	pos  int
+	line int
+}
+
+// parseTOML parses src into a map of keys to values.
+func parseTOML(src string) (map[string]any, error) {
+	p := &tomlParser{src: src, line: 1}
+	values := make(map[string]any)
+
+	for {
+		p.skipBlank(true)
+		if p.eof() {
+			return values, nil
+		}
+		if p.peek() == '[' {
+			return nil, p.errorf("tables are not supported")
+		}
+
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := values[key]; ok {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+		p.skipBlank(false)
+		if p.eof() || p.peek() != '=' {
+			return nil, p.errorf("expected '=' after key %q", key)
+		}
+		p.pos++
+		p.skipBlank(false)
+
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values[key] = v
+
+		p.skipBlank(false)
+		if !p.eof() && p.peek() != '\n' {
+			return nil, p.errorf("unexpected %q after value", p.peek())
+		}
+	}
+}
+
+// parseKey parses a bare or quoted key.
+func (p *tomlParser) parseKey() (string, error) {
+	switch p.peek() {
+	case '"', '\'':
+		return p.parseString()
+	}
+
+	start := p.pos
+	for !p.eof() && isBareKeyChar(p.peek()) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected key, got %q", p.peek())
+	}
+
+	return p.src[start:p.pos], nil
+}
+
+// parseValue parses a string, number, boolean or array value.
+func (p *tomlParser) parseValue() (any, error) {
+	if p.eof() {
+		return nil, p.errorf("expected value")
+	}
+
+	switch c := p.peek(); {
+	case c == '"' || c == '\'':
+		return p.parseString()
+	case c == '[':
+		return p.parseArray()
+	case strings.HasPrefix(p.src[p.pos:], "true"):
+		p.pos += len("true")
+		return true, nil
+	case strings.HasPrefix(p.src[p.pos:], "false"):
+		p.pos += len("false")
+		return false, nil
+	default:
+		return p.parseNumber()
+	}
+}
+
+// parseString parses a basic ("...") or literal ('...') string.
+func (p *tomlParser) parseString() (string, error) {
+	quote := p.peek()
+	start := p.pos
+	p.pos++
+	for !p.eof() {
+		c := p.peek()
+		switch {
+		case c == '\n':
+			return "", p.errorf("unterminated string")
+		case c == '\\' && quote == '"':
+			p.pos += 2
+			continue
+		case c == quote:
+			p.pos++
+			if quote == '\'' {
+				return p.src[start+1 : p.pos-1], nil
+			}
+			s, err := strconv.Unquote(p.src[start:p.pos])
+			if err != nil {
+				return "", p.errorf("invalid string %s", p.src[start:p.pos])
+			}
+			return s, nil
+		}
+		p.pos++
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+// parseArray parses an array of values, which may span several lines.
+func (p *tomlParser) parseArray() ([]any, error) {
+	p.pos++ // '['
+	values := []any{}
+	for {
+		p.skipBlank(true)
+		if p.eof() {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+
+		p.skipBlank(true)
+		if p.eof() {
+			return nil, p.errorf("unterminated array")
+		}
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return nil, p.errorf("expected ',' or ']' in array, got %q", p.peek())
+		}
+	}
+}
+
+// parseNumber parses an integer or float value.
+func (p *tomlParser) parseNumber() (any, error) {
+	start := p.pos
+	for !p.eof() && strings.IndexByte("+-0123456789._eE", p.peek()) >= 0 {
+		p.pos++
+	}
+	s := strings.ReplaceAll(p.src[start:p.pos], "_", "")
+	if s == "" {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number %q", s)
+	}
+
+	return f, nil
+}
+
+// skipBlank skips spaces, tabs and comments, and newlines if multiline is
+// true.
+func (p *tomlParser) skipBlank(multiline bool) {
+	for !p.eof() {
+		switch c := p.peek(); {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case c == '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		case c == '\n' && multiline:
+			p.line++
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *tomlParser) peek() byte {
+	return p.src[p.pos]
+}
+
+func (p *tomlParser) errorf(format string, a ...any) error {
+	return fmt.Errorf("toml: line %d: %s", p.line, fmt.Sprintf(format, a...))
+}
+
+// isBareKeyChar reports whether c is allowed in a bare TOML key.
+func isBareKeyChar(c byte) bool {
+	return c == '_' || c == '-' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
@@ -0,0 +1,50 @@
+package rotato
+
+import "unicode"
+
+// wideRanges holds the rune ranges displayed with two columns, East Asian
+// wide characters and emoji.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE30, 0xFE4F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x1F300, 0x1F64F},
+	{0x1F900, 0x1F9FF},
+	{0x20000, 0x3FFFD},
+}
+
+// textWidth returns the number of columns used to display s, ignoring ANSI
+// escape sequences.
+func textWidth(s string) int {
+	w := 0
+	for _, r := range removeANSI(s) {
+		w += runeWidth(r)
+	}
+
+	return w
+}
+
+// runeWidth returns the number of columns used to display r.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0, unicode.Is(unicode.Mn, r), unicode.Is(unicode.Me, r), unicode.IsControl(r):
+		return 0
+	case r == 0x200B, r == 0x200D, r >= 0xFE00 && r <= 0xFE0F:
+		return 0
+	}
+	for _, rg := range wideRanges {
+		if r >= rg[0] && r <= rg[1] {
+			return 2
+		}
+	}
+
+	return 1
+}